@@ -1,19 +1,32 @@
 package sendbird
 
+import "context"
+
 // AdminService is an interface for interfacing with the Admin
 // endpoints of the Sendbird API
 type AdminService interface {
 	BroadcastMessage(params *BroadcastMessageRequest) (*Response, error)
+	BroadcastMessageWithContext(ctx context.Context, params *BroadcastMessageRequest) (*Response, error)
 	ReadMessages(params *ReadMessagesRequest) ([]AdminMessage, *Response, error)
+	ReadMessagesWithContext(ctx context.Context, params *ReadMessagesRequest) ([]AdminMessage, *Response, error)
 	DeleteMessage(messageId string) (*DeleteMessage, *Response, error)
+	DeleteMessageWithContext(ctx context.Context, messageId string) (*DeleteMessage, *Response, error)
 	ListMessagingChannels(userId string) ([]AdminMessagingChannel, *Response, error)
+	ListMessagingChannelsWithContext(ctx context.Context, userId string) ([]AdminMessagingChannel, *Response, error)
 	MuteAllChannels(userId string) (*Response, error)
+	MuteAllChannelsWithContext(ctx context.Context, userId string) (*Response, error)
 	Mute(params *MuteRequest) ([]string, *Response, error)
+	MuteWithContext(ctx context.Context, params *MuteRequest) ([]string, *Response, error)
 	UnMuteAllChannels(userId string) (*Response, error)
+	UnMuteAllChannelsWithContext(ctx context.Context, userId string) (*Response, error)
 	UnMute(params *UnMuteRequest) ([]string, *Response, error)
+	UnMuteWithContext(ctx context.Context, params *UnMuteRequest) ([]string, *Response, error)
 	MuteList(channelUrls []string) ([]string, *Response, error)
+	MuteListWithContext(ctx context.Context, channelUrls []string) ([]string, *Response, error)
 	ConcurrentUserCount() (*ConcurrentUserCount, *Response, error)
+	ConcurrentUserCountWithContext(ctx context.Context) (*ConcurrentUserCount, *Response, error)
 	MemberCountInChannel(channelUrl string) (*ChannelMemberCount, *Response, error)
+	MemberCountInChannelWithContext(ctx context.Context, channelUrl string) (*ChannelMemberCount, *Response, error)
 }
 
 // AdminServiceOp handles communication with the AdminService related methods of
@@ -88,10 +101,19 @@ type ChannelMemberCount struct {
 // BroadcastMessages broadcasts an admin message to the channels
 // Please note that this feature is not available on Free and Sprout plans.
 func (s *AdminServiceOp) BroadcastMessage(params *BroadcastMessageRequest) (*Response, error) {
+	return s.BroadcastMessageWithContext(context.Background(), params)
+}
+
+// BroadcastMessageWithContext is like BroadcastMessage but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) BroadcastMessageWithContext(ctx context.Context, params *BroadcastMessageRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Admin.BroadcastMessage")
 
 	path := "/admin/broadcast_message"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -106,10 +128,19 @@ func (s *AdminServiceOp) BroadcastMessage(params *BroadcastMessageRequest) (*Res
 // ReadMessages reads messages from the target channel
 // Either channel_url or target_user_ids must be set
 func (s *AdminServiceOp) ReadMessages(params *ReadMessagesRequest) ([]AdminMessage, *Response, error) {
+	return s.ReadMessagesWithContext(context.Background(), params)
+}
+
+// ReadMessagesWithContext is like ReadMessages but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) ReadMessagesWithContext(ctx context.Context, params *ReadMessagesRequest) ([]AdminMessage, *Response, error) {
+	ctx = withOperation(ctx, "Admin.ReadMessages")
 
 	path := "/admin/read_messages"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messages := []AdminMessage{}
 	resp, err := s.client.Do(req, &messages)
@@ -123,6 +154,12 @@ func (s *AdminServiceOp) ReadMessages(params *ReadMessagesRequest) ([]AdminMessa
 
 // DeleteMessage deletes a message from your application
 func (s *AdminServiceOp) DeleteMessage(messageId string) (*DeleteMessage, *Response, error) {
+	return s.DeleteMessageWithContext(context.Background(), messageId)
+}
+
+// DeleteMessageWithContext is like DeleteMessage but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) DeleteMessageWithContext(ctx context.Context, messageId string) (*DeleteMessage, *Response, error) {
+	ctx = withOperation(ctx, "Admin.DeleteMessage")
 
 	path := "/admin/delete_message"
 
@@ -133,7 +170,10 @@ func (s *AdminServiceOp) DeleteMessage(messageId string) (*DeleteMessage, *Respo
 		MsgId: messageId,
 	}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	message := new(DeleteMessage)
 	resp, err := s.client.Do(req, message)
@@ -147,6 +187,12 @@ func (s *AdminServiceOp) DeleteMessage(messageId string) (*DeleteMessage, *Respo
 
 // listMessagingChannels lists messaging channels of the target user
 func (s *AdminServiceOp) ListMessagingChannels(userId string) ([]AdminMessagingChannel, *Response, error) {
+	return s.ListMessagingChannelsWithContext(context.Background(), userId)
+}
+
+// ListMessagingChannelsWithContext is like ListMessagingChannels but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) ListMessagingChannelsWithContext(ctx context.Context, userId string) ([]AdminMessagingChannel, *Response, error) {
+	ctx = withOperation(ctx, "Admin.ListMessagingChannels")
 
 	path := "/admin/list_messaging_channels"
 
@@ -157,7 +203,10 @@ func (s *AdminServiceOp) ListMessagingChannels(userId string) ([]AdminMessagingC
 		Id: userId,
 	}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	channels := []AdminMessagingChannel{}
 	resp, err := s.client.Do(req, &channels)
@@ -171,6 +220,12 @@ func (s *AdminServiceOp) ListMessagingChannels(userId string) ([]AdminMessagingC
 
 // MuteAllChannels mutes a user in all channels in your application. Prohibit a user from sending messages at all.
 func (s *AdminServiceOp) MuteAllChannels(userId string) (*Response, error) {
+	return s.MuteAllChannelsWithContext(context.Background(), userId)
+}
+
+// MuteAllChannelsWithContext is like MuteAllChannels but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) MuteAllChannelsWithContext(ctx context.Context, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "Admin.MuteAllChannels")
 
 	path := "/admin/mute"
 
@@ -181,7 +236,10 @@ func (s *AdminServiceOp) MuteAllChannels(userId string) (*Response, error) {
 		Id: userId,
 	}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -195,10 +253,19 @@ func (s *AdminServiceOp) MuteAllChannels(userId string) (*Response, error) {
 
 // Mute mutes a user in some channels in your application
 func (s *AdminServiceOp) Mute(params *MuteRequest) ([]string, *Response, error) {
+	return s.MuteWithContext(context.Background(), params)
+}
+
+// MuteWithContext is like Mute but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) MuteWithContext(ctx context.Context, params *MuteRequest) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "Admin.Mute")
 
 	path := "/admin/mute"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	channels := []string{}
 	resp, err := s.client.Do(req, &channels)
@@ -212,6 +279,12 @@ func (s *AdminServiceOp) Mute(params *MuteRequest) ([]string, *Response, error)
 
 // UnMuteAllChannels unmutes a user to start sending messages in your application. This doesn't unmute channel-wide mute
 func (s *AdminServiceOp) UnMuteAllChannels(userId string) (*Response, error) {
+	return s.UnMuteAllChannelsWithContext(context.Background(), userId)
+}
+
+// UnMuteAllChannelsWithContext is like UnMuteAllChannels but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) UnMuteAllChannelsWithContext(ctx context.Context, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "Admin.UnMuteAllChannels")
 
 	path := "/admin/unmute"
 
@@ -222,7 +295,10 @@ func (s *AdminServiceOp) UnMuteAllChannels(userId string) (*Response, error) {
 		Id: userId,
 	}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -236,10 +312,19 @@ func (s *AdminServiceOp) UnMuteAllChannels(userId string) (*Response, error) {
 
 // UnMute unmutes a user in some channels in your application
 func (s *AdminServiceOp) UnMute(params *UnMuteRequest) ([]string, *Response, error) {
+	return s.UnMuteWithContext(context.Background(), params)
+}
+
+// UnMuteWithContext is like UnMute but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) UnMuteWithContext(ctx context.Context, params *UnMuteRequest) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "Admin.UnMute")
 
 	path := "/admin/unmute"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	channels := []string{}
 	resp, err := s.client.Do(req, &channels)
@@ -253,6 +338,12 @@ func (s *AdminServiceOp) UnMute(params *UnMuteRequest) ([]string, *Response, err
 
 // MuteList gets the list of muted user ids in application-wide mute
 func (s *AdminServiceOp) MuteList(channelUrls []string) ([]string, *Response, error) {
+	return s.MuteListWithContext(context.Background(), channelUrls)
+}
+
+// MuteListWithContext is like MuteList but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) MuteListWithContext(ctx context.Context, channelUrls []string) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "Admin.MuteList")
 
 	path := "/admin/mute_list"
 
@@ -263,7 +354,10 @@ func (s *AdminServiceOp) MuteList(channelUrls []string) ([]string, *Response, er
 		ChannelUrls: channelUrls,
 	}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	userIds := []string{}
 	resp, err := s.client.Do(req, &userIds)
@@ -277,12 +371,21 @@ func (s *AdminServiceOp) MuteList(channelUrls []string) ([]string, *Response, er
 
 // ConcurrentUserCount gets the list of muted user ids in application-wide mute
 func (s *AdminServiceOp) ConcurrentUserCount() (*ConcurrentUserCount, *Response, error) {
+	return s.ConcurrentUserCountWithContext(context.Background())
+}
+
+// ConcurrentUserCountWithContext is like ConcurrentUserCount but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) ConcurrentUserCountWithContext(ctx context.Context) (*ConcurrentUserCount, *Response, error) {
+	ctx = withOperation(ctx, "Admin.ConcurrentUserCount")
 
 	path := "/admin/ccu_count"
 
 	params := struct{ RequestDefaults }{}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	count := new(ConcurrentUserCount)
 	resp, err := s.client.Do(req, count)
@@ -296,6 +399,12 @@ func (s *AdminServiceOp) ConcurrentUserCount() (*ConcurrentUserCount, *Response,
 
 // MemberCountInChannel gets the list of muted user ids in application-wide mute
 func (s *AdminServiceOp) MemberCountInChannel(channelUrl string) (*ChannelMemberCount, *Response, error) {
+	return s.MemberCountInChannelWithContext(context.Background(), channelUrl)
+}
+
+// MemberCountInChannelWithContext is like MemberCountInChannel but carries ctx through to the underlying HTTP request.
+func (s *AdminServiceOp) MemberCountInChannelWithContext(ctx context.Context, channelUrl string) (*ChannelMemberCount, *Response, error) {
+	ctx = withOperation(ctx, "Admin.MemberCountInChannel")
 
 	path := "/admin/member_count"
 
@@ -307,7 +416,10 @@ func (s *AdminServiceOp) MemberCountInChannel(channelUrl string) (*ChannelMember
 	}
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	count := new(ChannelMemberCount)
 	resp, err := s.client.Do(req, count)
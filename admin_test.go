@@ -28,7 +28,7 @@ func TestAdminBroadcastMessage(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -67,7 +67,7 @@ func TestAdminReadMessages(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -156,7 +156,7 @@ func TestAdminDeleteMessage(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -207,7 +207,7 @@ func TestAdminListMessagingChannels(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -290,7 +290,7 @@ func TestAdminMuteAllChannels(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -329,7 +329,7 @@ func TestAdminMute(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -372,7 +372,7 @@ func TestAdminUnMuteAllChannels(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -410,7 +410,7 @@ func TestAdminUnMute(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -452,7 +452,7 @@ func TestAdminMuteList(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -492,7 +492,7 @@ func TestConcurrentUserCount(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -533,7 +533,7 @@ func TestMemberCountInChannel(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -1,17 +1,96 @@
 package sendbird
 
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// headerApiToken carries the API token of requests authenticated with a header.
+const headerApiToken = "Api-Token"
+
+// AuthStrategy decides how the API token reaches Sendbird. Whatever the strategy, the token never appears in a
+// request URL, where it would end up in proxy and access logs.
+type AuthStrategy interface {
+	// Authenticate adds credentials to a request built by NewRequest, before it is sent.
+	Authenticate(req *http.Request, apiToken string) error
+
+	// BodyToken returns the token the legacy endpoints embed in their JSON body, in the auth or api_token field,
+	// or "" to leave it out.
+	BodyToken(apiToken string) string
+}
+
+// LegacyBodyAuth embeds the API token in the JSON bodies of the legacy endpoints, in the auth or api_token field,
+// where they expect it. The Api-Token header is only sent to the Platform API v3 endpoints and with requests that
+// have no body to carry the token, such as Bot.List and Bot.Get. It is the default strategy.
+type LegacyBodyAuth struct{}
+
+// Authenticate sets the Api-Token header of v3 and bodyless requests.
+func (LegacyBodyAuth) Authenticate(req *http.Request, apiToken string) error {
+	if isV3Request(req) || req.Body == nil || req.Body == http.NoBody {
+		req.Header.Set(headerApiToken, apiToken)
+	}
+	return nil
+}
+
+// BodyToken returns the API token.
+func (LegacyBodyAuth) BodyToken(apiToken string) string {
+	return apiToken
+}
+
+// HeaderAuth sends the API token in the Api-Token header of every request and keeps it out of request bodies, for
+// servers that accept the header on the legacy endpoints too.
+type HeaderAuth struct{}
+
+// Authenticate sets the Api-Token header.
+func (HeaderAuth) Authenticate(req *http.Request, apiToken string) error {
+	req.Header.Set(headerApiToken, apiToken)
+	return nil
+}
+
+// BodyToken returns "", bodies carry no token.
+func (HeaderAuth) BodyToken(apiToken string) string {
+	return ""
+}
+
+// isV3Request reports whether req targets a Platform API v3 endpoint.
+func isV3Request(req *http.Request) bool {
+	return strings.HasPrefix(RequestEndpointFamily(req), "/v3/")
+}
+
+// WithAuthStrategy sets how the API token is sent, LegacyBodyAuth by default.
+func WithAuthStrategy(a AuthStrategy) ClientOption {
+	return func(c *SendbirdClient) error {
+		if a == nil {
+			return errors.New("sendbird: auth strategy must not be nil")
+		}
+		c.auth = a
+		return nil
+	}
+}
+
+// authStrategy returns the strategy in use.
+func (c *SendbirdClient) authStrategy() AuthStrategy {
+	if c.auth == nil {
+		return LegacyBodyAuth{}
+	}
+	return c.auth
+}
+
 type RequestDefaults struct {
 	Auth string `json:"auth,omitempty"`
 }
 
+// PopulateAuthApiToken embeds the API token in the body when the client's auth strategy asks for it.
 func (s *RequestDefaults) PopulateAuthApiToken(client *SendbirdClient) {
-	s.Auth = client.ApiToken
+	s.Auth = client.authStrategy().BodyToken(client.ApiToken)
 }
 
 type RequestDefaultsAPIV2 struct {
-	ApiToken string `json:"api_token"`
+	ApiToken string `json:"api_token,omitempty"`
 }
 
+// PopulateApiV2Token embeds the API token in the body when the client's auth strategy asks for it.
 func (s *RequestDefaultsAPIV2) PopulateApiV2Token(client *SendbirdClient) {
-	s.ApiToken = client.ApiToken
+	s.ApiToken = client.authStrategy().BodyToken(client.ApiToken)
 }
@@ -0,0 +1,106 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestLegacyBodyAuthIsDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/create", func(w http.ResponseWriter, r *http.Request) {
+		body := UserRequest{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		CheckForAuthParam(t, r, body)
+		CheckForNoQueryToken(t, r)
+		if r.Header.Get("Api-Token") != "" {
+			t.Errorf("Api-Token header sent to a legacy endpoint")
+		}
+		w.Write([]byte("{}"))
+	})
+	mux.HandleFunc("/v3/users/john", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		w.Write([]byte("{}"))
+	})
+
+	if _, _, err := client.Users.Create(&UserRequest{Id: "john"}); err != nil {
+		t.Errorf("User.Create returned error: %v", err)
+	}
+	if _, _, err := client.UsersV3.Get("john"); err != nil {
+		t.Errorf("UserV3.Get returned error: %v", err)
+	}
+}
+
+func TestBotGetKeepsTokenOutOfURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		CheckForNoQueryToken(t, r)
+		w.Write([]byte("[]"))
+	})
+	mux.HandleFunc("/v2/bots/helper_bot", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		CheckForNoQueryToken(t, r)
+		w.Write([]byte("{}"))
+	})
+
+	if _, _, err := client.Bot.List(); err != nil {
+		t.Errorf("Bot.List returned error: %v", err)
+	}
+	if _, _, err := client.Bot.Get("helper_bot"); err != nil {
+		t.Errorf("Bot.Get returned error: %v", err)
+	}
+}
+
+func TestHeaderAuth(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client, _ = NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithBaseURL(server.URL), WithAuthStrategy(HeaderAuth{}))
+
+	mux.HandleFunc("/user/create", func(w http.ResponseWriter, r *http.Request) {
+		body := UserRequest{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		CheckForHeaderAuth(t, r, body)
+		w.Write([]byte("{}"))
+	})
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		body := BotRequest{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		CheckForHeaderAuth(t, r, body)
+		w.Write([]byte("{}"))
+	})
+
+	if _, _, err := client.Users.Create(&UserRequest{Id: "john"}); err != nil {
+		t.Errorf("User.Create returned error: %v", err)
+	}
+	if _, _, err := client.Bot.Create(&BotRequest{BotUserId: "helper_bot"}); err != nil {
+		t.Errorf("Bot.Create returned error: %v", err)
+	}
+}
+
+type failingAuth struct{ err error }
+
+func (a failingAuth) Authenticate(req *http.Request, apiToken string) error { return a.err }
+func (a failingAuth) BodyToken(apiToken string) string                      { return "" }
+
+func TestAuthStrategyError(t *testing.T) {
+	authErr := errors.New("no token available")
+	c, _ := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithAuthStrategy(failingAuth{authErr}))
+
+	if _, err := c.NewRequest("GET", "v2/bots", nil); !errors.Is(err, authErr) {
+		t.Errorf("NewRequest returned %v, expected the strategy's error", err)
+	}
+
+	if _, err := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithAuthStrategy(nil)); err == nil {
+		t.Errorf("NewClient accepted a nil auth strategy")
+	}
+}
@@ -1,23 +1,30 @@
 package sendbird
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
+	"sync"
 )
 
 // BotService is an interface for interfacing with the Bot
 // endpoints of the Sendbird API
 type BotService interface {
 	Create(params *BotRequest) (*Bot, *Response, error)
+	CreateWithContext(ctx context.Context, params *BotRequest) (*Bot, *Response, error)
 	SendMessage(botUserId string, params *BotMessageRequest) (*BotMessage, *Response, error)
+	SendMessageWithContext(ctx context.Context, botUserId string, params *BotMessageRequest) (*BotMessage, *Response, error)
 	List() ([]Bot, *Response, error)
+	ListWithContext(ctx context.Context) ([]Bot, *Response, error)
 	Get(botUserId string) (*Bot, *Response, error)
+	GetWithContext(ctx context.Context, botUserId string) (*Bot, *Response, error)
 	Update(botUserId string, params *BotUpdateRequest) (*Bot, *Response, error)
+	UpdateWithContext(ctx context.Context, botUserId string, params *BotUpdateRequest) (*Bot, *Response, error)
 	Delete(botUserId string) (*BotUserId, *Response, error)
+	DeleteWithContext(ctx context.Context, botUserId string) (*BotUserId, *Response, error)
 	Handler(rw http.ResponseWriter, req *http.Request)
+	ServeHTTP(rw http.ResponseWriter, req *http.Request)
+	Shutdown(ctx context.Context) error
 }
 
 type MessageReceivedHandler func(*BotCallback)
@@ -27,6 +34,27 @@ type MessageReceivedHandler func(*BotCallback)
 type BotServiceOp struct {
 	client          *SendbirdClient
 	MessageReceived MessageReceivedHandler
+
+	// Optional lookup of registered bot tokens. When set, Handler rejects callbacks whose bot_token doesn't match
+	// the token of the bot they are addressed to. Without it, callbacks must carry a valid signature header.
+	TokenLookup BotTokenLookup
+
+	// Maximum size of a callback body, DefaultMaxCallbackBytes when zero. Larger bodies are answered with 413.
+	MaxBodyBytes int64
+
+	// Optional function told about every rejected callback
+	OnError CallbackErrorHandler
+
+	// Optional dispatcher delivering callbacks. When nil, callbacks go to MessageReceived through a dispatcher
+	// with the default DispatcherConfig.
+	Dispatcher *BotDispatcher
+
+	// Optional store of accepted callbacks, see NewMemoryDedupeStore. When set, callbacks delivered again are
+	// answered with 200 without being dispatched.
+	Dedupe DedupeStore
+
+	dispatcherOnce sync.Once
+	dispatcher     *BotDispatcher
 }
 
 var _ BotService = &BotServiceOp{}
@@ -85,10 +113,19 @@ type BotCallback struct {
 
 // Create a bot
 func (s *BotServiceOp) Create(params *BotRequest) (*Bot, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) CreateWithContext(ctx context.Context, params *BotRequest) (*Bot, *Response, error) {
+	ctx = withOperation(ctx, "Bot.Create")
 
 	path := "/v2/bots"
 	params.PopulateApiV2Token(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bot := new(Bot)
 	resp, err := s.client.Do(req, bot)
@@ -102,10 +139,19 @@ func (s *BotServiceOp) Create(params *BotRequest) (*Bot, *Response, error) {
 
 // Send Message
 func (s *BotServiceOp) SendMessage(botUserId string, params *BotMessageRequest) (*BotMessage, *Response, error) {
+	return s.SendMessageWithContext(context.Background(), botUserId, params)
+}
+
+// SendMessageWithContext is like SendMessage but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) SendMessageWithContext(ctx context.Context, botUserId string, params *BotMessageRequest) (*BotMessage, *Response, error) {
+	ctx = withOperation(ctx, "Bot.SendMessage")
 
 	path := fmt.Sprintf("v2/bots/%s/send", botUserId)
 	params.PopulateApiV2Token(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	message := new(BotMessage)
 	resp, err := s.client.Do(req, message)
@@ -119,9 +165,18 @@ func (s *BotServiceOp) SendMessage(botUserId string, params *BotMessageRequest)
 
 // Get a list of bots in your application
 func (s *BotServiceOp) List() ([]Bot, *Response, error) {
+	return s.ListWithContext(context.Background())
+}
 
-	path := fmt.Sprintf("v2/bots?api_token=%s", s.client.ApiToken)
-	req, err := s.client.NewRequest("GET", path, nil)
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) ListWithContext(ctx context.Context) ([]Bot, *Response, error) {
+	ctx = withOperation(ctx, "Bot.List")
+
+	path := "v2/bots"
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bots := []Bot{}
 	resp, err := s.client.Do(req, &bots)
@@ -135,9 +190,18 @@ func (s *BotServiceOp) List() ([]Bot, *Response, error) {
 
 // Retrieve a bot
 func (s *BotServiceOp) Get(botUserId string) (*Bot, *Response, error) {
+	return s.GetWithContext(context.Background(), botUserId)
+}
 
-	path := fmt.Sprintf("v2/bots/%s?api_token=%s", botUserId, s.client.ApiToken)
-	req, err := s.client.NewRequest("GET", path, nil)
+// GetWithContext is like Get but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) GetWithContext(ctx context.Context, botUserId string) (*Bot, *Response, error) {
+	ctx = withOperation(ctx, "Bot.Get")
+
+	path := fmt.Sprintf("v2/bots/%s", botUserId)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bot := new(Bot)
 	resp, err := s.client.Do(req, bot)
@@ -151,10 +215,19 @@ func (s *BotServiceOp) Get(botUserId string) (*Bot, *Response, error) {
 
 // Update a bot
 func (s *BotServiceOp) Update(botUserId string, params *BotUpdateRequest) (*Bot, *Response, error) {
+	return s.UpdateWithContext(context.Background(), botUserId, params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) UpdateWithContext(ctx context.Context, botUserId string, params *BotUpdateRequest) (*Bot, *Response, error) {
+	ctx = withOperation(ctx, "Bot.Update")
 
 	path := fmt.Sprintf("v2/bots/%s", botUserId)
 	params.PopulateApiV2Token(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bot := new(Bot)
 	resp, err := s.client.Do(req, bot)
@@ -168,11 +241,20 @@ func (s *BotServiceOp) Update(botUserId string, params *BotUpdateRequest) (*Bot,
 
 // Delete a bot
 func (s *BotServiceOp) Delete(botUserId string) (*BotUserId, *Response, error) {
+	return s.DeleteWithContext(context.Background(), botUserId)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *BotServiceOp) DeleteWithContext(ctx context.Context, botUserId string) (*BotUserId, *Response, error) {
+	ctx = withOperation(ctx, "Bot.Delete")
 
 	params := RequestDefaultsAPIV2{}
 	params.PopulateApiV2Token(s.client)
 	path := fmt.Sprintf("v2/bots/%s", botUserId)
-	req, err := s.client.NewRequest("DELETE", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	bot := new(BotUserId)
 	resp, err := s.client.Do(req, bot)
@@ -183,29 +265,3 @@ func (s *BotServiceOp) Delete(botUserId string) (*BotUserId, *Response, error) {
 
 	return bot, resp, nil
 }
-
-func (s *BotServiceOp) Handler(rw http.ResponseWriter, req *http.Request) {
-	read, err := ioutil.ReadAll(req.Body)
-
-	if err != nil {
-		log.Fatal(err)
-		rw.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	//TODO: Message integrity check here
-
-	callbackData := new(BotCallback)
-	err = json.Unmarshal(read, callbackData)
-	if err != nil {
-		log.Fatal("Couldn't parse sendbird json:" + err.Error())
-		rw.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	if s.MessageReceived != nil {
-		go s.MessageReceived(callbackData)
-	}
-
-	rw.WriteHeader(http.StatusOK)
-}
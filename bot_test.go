@@ -28,7 +28,7 @@ func TestBotCreate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForV2ApiTokenParam(t, r, body)
@@ -85,7 +85,7 @@ func TestBotSendMessage(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForV2ApiTokenParam(t, r, body)
@@ -130,7 +130,6 @@ func TestBotList(t *testing.T) {
 		testMethod(t, r, "GET")
 
 		CheckForAuthContentType(t, r)
-		CheckForV2ApiTokenQueryString(t, r)
 
 		response := `
 		[
@@ -187,7 +186,6 @@ func TestBotGet(t *testing.T) {
 		testMethod(t, r, "GET")
 
 		CheckForAuthContentType(t, r)
-		CheckForV2ApiTokenQueryString(t, r)
 
 		response := `
 		{
@@ -237,7 +235,7 @@ func TestBotUpdate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForV2ApiTokenParam(t, r, body)
@@ -288,7 +286,7 @@ func TestBotDelete(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForV2ApiTokenParam(t, r, body)
@@ -0,0 +1,75 @@
+package sendbird
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+)
+
+// SignatureHeader is the header in which Sendbird sends the HMAC-SHA256 signature of a callback body, hex encoded
+// and keyed with the application's API token.
+const SignatureHeader = "X-Sendbird-Signature"
+
+var (
+	// ErrInvalidSignature is reported when a callback's signature header doesn't match its body.
+	ErrInvalidSignature = errors.New("sendbird: invalid callback signature")
+
+	// ErrInvalidBotToken is reported when a callback's bot_token doesn't match the registered bot.
+	ErrInvalidBotToken = errors.New("sendbird: invalid bot token")
+
+	// ErrUnverifiedCallback is reported when a callback has no signature header and no TokenLookup is configured
+	// to check its bot_token, so nothing proves it comes from Sendbird.
+	ErrUnverifiedCallback = errors.New("sendbird: callback is neither signed nor checked against a bot token")
+)
+
+// BotTokenLookup returns the token of a registered bot, or false when the bot is unknown. It lets several bots
+// share one callback endpoint.
+type BotTokenLookup func(botUserId string) (token string, ok bool)
+
+// StaticBotTokens returns a lookup over a fixed map of bot user id to bot token.
+func StaticBotTokens(tokens map[string]string) BotTokenLookup {
+	return func(botUserId string) (string, bool) {
+		token, ok := tokens[botUserId]
+		return token, ok
+	}
+}
+
+// VerifySignature reports whether signature is the hex encoded HMAC-SHA256 of body keyed with apiToken.
+func VerifySignature(body []byte, signature, apiToken string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(apiToken))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// verifySignature checks the signature header of a callback and reports whether it carried a valid one.
+func (s *BotServiceOp) verifySignature(header http.Header, body []byte) (bool, error) {
+	signature := header.Get(SignatureHeader)
+	if signature == "" {
+		return false, nil
+	}
+	if !VerifySignature(body, signature, s.client.ApiToken) {
+		return false, ErrInvalidSignature
+	}
+	return true, nil
+}
+
+// verifyBotToken checks a callback's bot_token against TokenLookup, when one is configured.
+func (s *BotServiceOp) verifyBotToken(callback *BotCallback) error {
+	if s.TokenLookup == nil {
+		return nil
+	}
+
+	token, ok := s.TokenLookup(callback.BotUserId)
+	if !ok || token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(callback.BotToken)) != 1 {
+		return ErrInvalidBotToken
+	}
+	return nil
+}
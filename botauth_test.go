@@ -0,0 +1,120 @@
+package sendbird
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testCallback = `{"bot_userid": "helper_bot", "bot_token": "BOT_TOKEN", "sender_username": "john", "message": "hi", "channel_url": "url"}`
+
+func sign(body, key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signed returns the header signing body with the test clients' API token.
+func signed(body string) http.Header {
+	return http.Header{SignatureHeader: {sign(body, "SENDBIRD_API_TOKEN")}}
+}
+
+func postCallback(bot *BotServiceOp, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/sendbird_bot", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	bot.Handler(rec, req)
+	return rec
+}
+
+func TestBotHandlerVerifiesBotToken(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+	bot.TokenLookup = StaticBotTokens(map[string]string{"helper_bot": "BOT_TOKEN"})
+
+	received := make(chan *BotCallback, 1)
+	bot.MessageReceived = func(cb *BotCallback) { received <- cb }
+
+	if rec := postCallback(bot, testCallback, nil); rec.Code != http.StatusOK {
+		t.Fatalf("Handler returned %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	select {
+	case cb := <-received:
+		if cb.SenderUsername != "john" {
+			t.Errorf("MessageReceived got %+v", cb)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("MessageReceived was not called")
+	}
+
+	forged := strings.Replace(testCallback, "BOT_TOKEN", "FORGED", 1)
+	if rec := postCallback(bot, forged, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned %d for a forged token, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	unknown := strings.Replace(testCallback, "helper_bot", "other_bot", 1)
+	if rec := postCallback(bot, unknown, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned %d for an unknown bot, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	select {
+	case cb := <-received:
+		t.Errorf("MessageReceived called for a rejected callback: %+v", cb)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestBotHandlerVerifiesSignature(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+
+	if rec := postCallback(bot, testCallback, signed(testCallback)); rec.Code != http.StatusOK {
+		t.Errorf("Handler returned %d for a valid signature, expected %d", rec.Code, http.StatusOK)
+	}
+
+	invalid := http.Header{SignatureHeader: {sign(testCallback, "WRONG_TOKEN")}}
+	if rec := postCallback(bot, testCallback, invalid); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned %d for an invalid signature, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestBotHandlerRejectsUnverifiedCallbacks(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+
+	var reported error
+	bot.OnError = func(req *http.Request, err error) { reported = err }
+
+	if rec := postCallback(bot, testCallback, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned %d for an unsigned callback, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+	if !errors.Is(reported, ErrUnverifiedCallback) {
+		t.Errorf("OnError got %v, expected ErrUnverifiedCallback", reported)
+	}
+
+	// a signed callback must still carry a valid bot token when a lookup is configured
+	bot.TokenLookup = StaticBotTokens(map[string]string{"helper_bot": "OTHER_TOKEN"})
+	if rec := postCallback(bot, testCallback, signed(testCallback)); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned %d for a signed callback with a wrong token, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	if !VerifySignature([]byte("body"), sign("body", "key"), "key") {
+		t.Errorf("VerifySignature rejected a valid signature")
+	}
+
+	if VerifySignature([]byte("body"), "not-hex", "key") {
+		t.Errorf("VerifySignature accepted a malformed signature")
+	}
+}
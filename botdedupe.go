@@ -0,0 +1,117 @@
+package sendbird
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDedupeWindow is how long a MemoryDedupeStore remembers a callback when no window is given.
+	DefaultDedupeWindow = 10 * time.Minute
+
+	// DefaultDedupeCapacity is how many callbacks a MemoryDedupeStore remembers when no capacity is given.
+	DefaultDedupeCapacity = 10000
+)
+
+// DedupeStore remembers the callbacks already accepted, so that deliveries retried by Sendbird are dispatched at
+// most once. Implementations must be safe for concurrent use.
+type DedupeStore interface {
+	// Seen records key and reports whether it was already recorded.
+	Seen(key string) bool
+
+	// Forget removes key, so that a later delivery of the callback is accepted again.
+	Forget(key string)
+}
+
+// DedupeKey identifies a callback delivery by bot, channel, sender and timestamp.
+func DedupeKey(callback *BotCallback) string {
+	return fmt.Sprintf("%s|%s|%s|%d", callback.BotUserId, callback.ChannelURl, callback.SenderUsername, callback.Timestamp)
+}
+
+type dedupeEntry struct {
+	key     string
+	expires time.Time
+}
+
+// MemoryDedupeStore is an in-memory DedupeStore remembering keys for a window, evicting the least recently
+// recorded keys once it holds its capacity.
+type MemoryDedupeStore struct {
+	window   time.Duration
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front is the most recently recorded
+	now     func() time.Time
+}
+
+var _ DedupeStore = &MemoryDedupeStore{}
+
+// NewMemoryDedupeStore returns a store remembering up to capacity keys for window. Zero values select
+// DefaultDedupeCapacity and DefaultDedupeWindow.
+func NewMemoryDedupeStore(capacity int, window time.Duration) *MemoryDedupeStore {
+	if capacity <= 0 {
+		capacity = DefaultDedupeCapacity
+	}
+	if window <= 0 {
+		window = DefaultDedupeWindow
+	}
+
+	return &MemoryDedupeStore{
+		window:   window,
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Seen records key and reports whether it was already recorded within the window.
+func (s *MemoryDedupeStore) Seen(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if el, ok := s.entries[key]; ok {
+		if now.Before(el.Value.(*dedupeEntry).expires) {
+			return true
+		}
+		s.remove(el)
+	}
+
+	// keys share the window, so the expired ones are at the back
+	for back := s.order.Back(); back != nil && !now.Before(back.Value.(*dedupeEntry).expires); back = s.order.Back() {
+		s.remove(back)
+	}
+
+	s.entries[key] = s.order.PushFront(&dedupeEntry{key: key, expires: now.Add(s.window)})
+	for s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return false
+}
+
+// Forget removes key from the store.
+func (s *MemoryDedupeStore) Forget(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.entries[key]; ok {
+		s.remove(el)
+	}
+}
+
+// Len returns the number of keys held.
+func (s *MemoryDedupeStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+func (s *MemoryDedupeStore) remove(el *list.Element) {
+	s.order.Remove(el)
+	delete(s.entries, el.Value.(*dedupeEntry).key)
+}
@@ -0,0 +1,89 @@
+package sendbird
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryDedupeStore(t *testing.T) {
+	s := NewMemoryDedupeStore(2, time.Minute)
+	now := time.Unix(1500000000, 0)
+	s.now = func() time.Time { return now }
+
+	if s.Seen("a") {
+		t.Errorf("a seen before being recorded")
+	}
+	if !s.Seen("a") {
+		t.Errorf("a not seen after being recorded")
+	}
+
+	// capacity is 2, so recording c evicts a
+	s.Seen("b")
+	s.Seen("c")
+	if s.Len() != 2 {
+		t.Errorf("store holds %d keys, expected 2", s.Len())
+	}
+	if s.Seen("a") {
+		t.Errorf("a seen after being evicted")
+	}
+
+	s.Forget("a")
+	if s.Seen("a") {
+		t.Errorf("a seen after being forgotten")
+	}
+
+	now = now.Add(time.Minute)
+	if s.Seen("a") {
+		t.Errorf("a seen after the window")
+	}
+	if s.Len() != 1 {
+		t.Errorf("store holds %d keys after the window, expected 1", s.Len())
+	}
+}
+
+func TestBotHandlerDropsDuplicates(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+	bot.Dedupe = NewMemoryDedupeStore(0, 0)
+
+	received := 0
+	bot.Dispatcher = NewBotDispatcher(func(cb *BotCallback) { received++ }, DispatcherConfig{Synchronous: true})
+
+	for i := 0; i < 3; i++ {
+		if rec := postCallback(bot, testCallback, signed(testCallback)); rec.Code != http.StatusOK {
+			t.Errorf("delivery %d answered %d, expected %d", i, rec.Code, http.StatusOK)
+		}
+	}
+	if received != 1 {
+		t.Errorf("callback dispatched %d times, expected 1", received)
+	}
+
+	// a new message of the same sender has another timestamp
+	next := strings.Replace(testCallback, `"message"`, `"ts": 1500000000, "message"`, 1)
+	postCallback(bot, next, signed(next))
+	if received != 2 {
+		t.Errorf("callback dispatched %d times, expected 2", received)
+	}
+}
+
+func TestBotHandlerAcceptsRetryAfterFailedDispatch(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+	bot.Dedupe = NewMemoryDedupeStore(0, 0)
+
+	received := 0
+	bot.Dispatcher = NewBotDispatcher(func(cb *BotCallback) { received++ }, DispatcherConfig{Synchronous: true})
+	bot.Dispatcher.Shutdown(context.Background())
+
+	if rec := postCallback(bot, testCallback, signed(testCallback)); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("delivery to a closed dispatcher answered %d, expected %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	bot.Dispatcher = NewBotDispatcher(func(cb *BotCallback) { received++ }, DispatcherConfig{Synchronous: true})
+	if rec := postCallback(bot, testCallback, signed(testCallback)); rec.Code != http.StatusOK || received != 1 {
+		t.Errorf("retry answered %d and dispatched %d times, expected %d and 1", rec.Code, received, http.StatusOK)
+	}
+}
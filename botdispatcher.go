@@ -0,0 +1,181 @@
+package sendbird
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+)
+
+const (
+	defaultDispatcherWorkers   = 4
+	defaultDispatcherQueueSize = 64
+)
+
+var (
+	// ErrDispatcherFull is returned by Dispatch when the queue of the callback's channel is full. The bot handler
+	// answers with 503 so that Sendbird retries the delivery later.
+	ErrDispatcherFull = errors.New("sendbird: bot dispatcher queue is full")
+
+	// ErrDispatcherClosed is returned by Dispatch once Shutdown was called.
+	ErrDispatcherClosed = errors.New("sendbird: bot dispatcher is shut down")
+)
+
+// DispatcherConfig configures a BotDispatcher.
+type DispatcherConfig struct {
+	// Number of workers, 4 when zero. Callbacks of one channel are always handled by the same worker.
+	Workers int
+
+	// Number of callbacks each worker can hold before Dispatch fails with ErrDispatcherFull, 64 when zero.
+	QueueSize int
+
+	// Run the handler inline from Dispatch instead of on a worker. Meant for tests.
+	Synchronous bool
+
+	// Optional function told about a handler panic. The panic is recovered either way.
+	OnPanic func(callback *BotCallback, recovered interface{})
+}
+
+// BotDispatcher delivers bot callbacks to a MessageReceivedHandler from a bounded pool of workers. Callbacks of
+// the same channel are delivered in the order they were dispatched.
+type BotDispatcher struct {
+	handler MessageReceivedHandler
+	config  DispatcherConfig
+
+	mu     sync.RWMutex
+	closed bool
+	queues []chan *BotCallback
+	wg     sync.WaitGroup // workers, or in-flight calls in synchronous mode
+}
+
+// NewBotDispatcher starts a dispatcher delivering callbacks to handler.
+func NewBotDispatcher(handler MessageReceivedHandler, config DispatcherConfig) *BotDispatcher {
+	if config.Workers <= 0 {
+		config.Workers = defaultDispatcherWorkers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultDispatcherQueueSize
+	}
+
+	d := &BotDispatcher{handler: handler, config: config}
+	if config.Synchronous {
+		return d
+	}
+
+	d.queues = make([]chan *BotCallback, config.Workers)
+	for i := range d.queues {
+		d.queues[i] = make(chan *BotCallback, config.QueueSize)
+		d.wg.Add(1)
+		go d.work(d.queues[i])
+	}
+	return d
+}
+
+// Dispatch queues a callback for delivery. It never blocks: when the channel's queue is full it returns
+// ErrDispatcherFull.
+func (d *BotDispatcher) Dispatch(callback *BotCallback) error {
+	d.mu.RLock()
+	if d.closed {
+		d.mu.RUnlock()
+		return ErrDispatcherClosed
+	}
+
+	if d.config.Synchronous {
+		// don't hold the lock while the handler runs, it may call Shutdown
+		d.wg.Add(1)
+		d.mu.RUnlock()
+		defer d.wg.Done()
+
+		d.deliver(callback)
+		return nil
+	}
+	defer d.mu.RUnlock()
+
+	select {
+	case d.queues[d.shard(callback.ChannelURl)] <- callback:
+		return nil
+	default:
+		return ErrDispatcherFull
+	}
+}
+
+// Shutdown stops accepting callbacks and waits for the queued and in-flight ones to be handled, or for ctx to be
+// done, in which case it returns ctx's error and the remaining callbacks are still delivered in the background.
+// Called from a handler, it can only return once ctx is done, since it waits for that handler too.
+func (d *BotDispatcher) Shutdown(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		for _, q := range d.queues {
+			close(q)
+		}
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *BotDispatcher) work(queue chan *BotCallback) {
+	defer d.wg.Done()
+	for callback := range queue {
+		d.deliver(callback)
+	}
+}
+
+// deliver calls the handler, recovering from panics.
+func (d *BotDispatcher) deliver(callback *BotCallback) {
+	defer func() {
+		if r := recover(); r != nil && d.config.OnPanic != nil {
+			d.config.OnPanic(callback, r)
+		}
+	}()
+	d.handler(callback)
+}
+
+func (d *BotDispatcher) shard(channelUrl string) int {
+	h := fnv.New32a()
+	h.Write([]byte(channelUrl))
+	return int(h.Sum32() % uint32(len(d.queues)))
+}
+
+// dispatch hands a verified callback to Dispatcher, or to a default dispatcher calling MessageReceived.
+func (s *BotServiceOp) dispatch(callback *BotCallback) error {
+	if s.Dispatcher != nil {
+		return s.Dispatcher.Dispatch(callback)
+	}
+	if s.MessageReceived == nil {
+		return nil
+	}
+	return s.defaultDispatcher().Dispatch(callback)
+}
+
+func (s *BotServiceOp) defaultDispatcher() *BotDispatcher {
+	s.dispatcherOnce.Do(func() {
+		s.dispatcher = NewBotDispatcher(func(callback *BotCallback) {
+			if s.MessageReceived != nil {
+				s.MessageReceived(callback)
+			}
+		}, DispatcherConfig{})
+	})
+	return s.dispatcher
+}
+
+// Shutdown stops accepting callbacks and waits for the ones already accepted to be handled, see
+// BotDispatcher.Shutdown. Callbacks received afterwards are answered with 503.
+func (s *BotServiceOp) Shutdown(ctx context.Context) error {
+	if s.Dispatcher != nil {
+		return s.Dispatcher.Shutdown(ctx)
+	}
+	return s.defaultDispatcher().Shutdown(ctx)
+}
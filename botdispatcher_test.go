@@ -0,0 +1,186 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDispatcherOrdersPerChannel(t *testing.T) {
+	var mu sync.Mutex
+	got := map[string][]int64{}
+
+	d := NewBotDispatcher(func(cb *BotCallback) {
+		mu.Lock()
+		got[cb.ChannelURl] = append(got[cb.ChannelURl], cb.Timestamp)
+		mu.Unlock()
+	}, DispatcherConfig{Workers: 3, QueueSize: 200})
+
+	for i := int64(0); i < 50; i++ {
+		for _, channel := range []string{"a", "b", "c", "d"} {
+			if err := d.Dispatch(&BotCallback{ChannelURl: channel, Timestamp: i}); err != nil {
+				t.Fatalf("Dispatch returned error: %v", err)
+			}
+		}
+	}
+
+	if err := d.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+
+	for channel, timestamps := range got {
+		if len(timestamps) != 50 {
+			t.Errorf("channel %s received %d callbacks, expected 50", channel, len(timestamps))
+		}
+		for i, ts := range timestamps {
+			if ts != int64(i) {
+				t.Errorf("channel %s received callbacks out of order: %v", channel, timestamps)
+				break
+			}
+		}
+	}
+}
+
+func TestDispatcherBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	d := NewBotDispatcher(func(cb *BotCallback) { <-release }, DispatcherConfig{Workers: 1, QueueSize: 1})
+
+	// one in flight, one queued
+	d.Dispatch(&BotCallback{ChannelURl: "a"})
+	time.Sleep(10 * time.Millisecond)
+	if err := d.Dispatch(&BotCallback{ChannelURl: "a"}); err != nil {
+		t.Fatalf("Dispatch returned error: %v", err)
+	}
+
+	if err := d.Dispatch(&BotCallback{ChannelURl: "a"}); err != ErrDispatcherFull {
+		t.Errorf("Dispatch on a full queue returned %v, expected %v", err, ErrDispatcherFull)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := d.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Shutdown with blocked handlers returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	if err := d.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown returned error: %v", err)
+	}
+
+	if err := d.Dispatch(&BotCallback{ChannelURl: "a"}); err != ErrDispatcherClosed {
+		t.Errorf("Dispatch after Shutdown returned %v, expected %v", err, ErrDispatcherClosed)
+	}
+}
+
+func TestDispatcherRecoversPanics(t *testing.T) {
+	var recovered []interface{}
+	var delivered []string
+
+	d := NewBotDispatcher(func(cb *BotCallback) {
+		if cb.Message == "boom" {
+			panic("boom")
+		}
+		delivered = append(delivered, cb.Message)
+	}, DispatcherConfig{
+		Synchronous: true,
+		OnPanic:     func(cb *BotCallback, r interface{}) { recovered = append(recovered, r) },
+	})
+
+	d.Dispatch(&BotCallback{Message: "boom"})
+	d.Dispatch(&BotCallback{Message: "hi"})
+
+	if !reflect.DeepEqual(recovered, []interface{}{"boom"}) || !reflect.DeepEqual(delivered, []string{"hi"}) {
+		t.Errorf("recovered %v and delivered %v", recovered, delivered)
+	}
+}
+
+func TestDispatcherShutdownFromSynchronousHandler(t *testing.T) {
+	var d *BotDispatcher
+	shutdown := make(chan error, 1)
+	d = NewBotDispatcher(func(cb *BotCallback) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		shutdown <- d.Shutdown(ctx)
+	}, DispatcherConfig{Synchronous: true})
+
+	done := make(chan error, 1)
+	go func() { done <- d.Dispatch(&BotCallback{ChannelURl: "a"}) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Dispatch returned %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Shutdown called from the handler deadlocked")
+	}
+
+	if err := <-shutdown; err != context.DeadlineExceeded {
+		t.Errorf("Shutdown from the handler returned %v, expected it to wait until ctx was done", err)
+	}
+	if err := d.Dispatch(&BotCallback{ChannelURl: "a"}); err != ErrDispatcherClosed {
+		t.Errorf("Dispatch after Shutdown returned %v, expected ErrDispatcherClosed", err)
+	}
+}
+
+func TestDispatcherShutdownWaitsForSynchronousCalls(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d := NewBotDispatcher(func(cb *BotCallback) {
+		close(started)
+		<-release
+	}, DispatcherConfig{Synchronous: true})
+
+	go d.Dispatch(&BotCallback{ChannelURl: "a"})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := d.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Shutdown returned %v while a call was in flight, expected ctx's error", err)
+	}
+
+	close(release)
+	if err := d.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown returned %v once the call finished", err)
+	}
+}
+
+func TestBotHandlerDispatcher(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+
+	var received []string
+	bot.Dispatcher = NewBotDispatcher(func(cb *BotCallback) {
+		received = append(received, cb.Message)
+	}, DispatcherConfig{Synchronous: true})
+
+	post := func() int {
+		req := httptest.NewRequest("POST", "/sendbird_bot", strings.NewReader(testCallback))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(SignatureHeader, sign(testCallback, "SENDBIRD_API_TOKEN"))
+		rec := httptest.NewRecorder()
+		bot.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := post(); code != http.StatusOK {
+		t.Fatalf("handler returned %d, expected %d", code, http.StatusOK)
+	}
+
+	// synchronous mode delivers before the handler returns
+	if fmt.Sprint(received) != "[hi]" {
+		t.Errorf("dispatcher received %v", received)
+	}
+
+	bot.Shutdown(context.Background())
+	if code := post(); code != http.StatusServiceUnavailable {
+		t.Errorf("handler returned %d after Shutdown, expected %d", code, http.StatusServiceUnavailable)
+	}
+}
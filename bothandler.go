@@ -0,0 +1,128 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+)
+
+// DefaultMaxCallbackBytes is the largest callback body accepted by BotServiceOp when MaxBodyBytes is not set.
+const DefaultMaxCallbackBytes = 1 << 20
+
+// CallbackError describes why a bot callback was rejected, along with the status it was answered with.
+type CallbackError struct {
+	Status int
+	Err    error
+}
+
+func (e *CallbackError) Error() string {
+	return fmt.Sprintf("sendbird: callback rejected with %d: %v", e.Status, e.Err)
+}
+
+func (e *CallbackError) Unwrap() error {
+	return e.Err
+}
+
+// CallbackErrorHandler is told about every callback rejected by the bot handler. err is a *CallbackError.
+type CallbackErrorHandler func(req *http.Request, err error)
+
+// Handler receives bot callbacks from Sendbird and passes them to MessageReceived. It is the same as ServeHTTP.
+func (s *BotServiceOp) Handler(rw http.ResponseWriter, req *http.Request) {
+	s.ServeHTTP(rw, req)
+}
+
+// ServeHTTP receives bot callbacks from Sendbird and hands them to the dispatcher, which calls MessageReceived.
+// When Dedupe is set, callbacks already accepted are answered with 200 and dropped.
+// Rejected requests are answered with an error status and a short reason, and reported to OnError:
+//
+//	405 the method isn't POST
+//	415 the content type isn't application/json
+//	413 the body is larger than MaxBodyBytes
+//	400 the body can't be read or isn't a valid callback
+//	401 the signature header or the bot_token doesn't match, see TokenLookup, or the callback is unsigned
+//	    and there is no TokenLookup to check it against
+//	503 the dispatcher queue is full or the service is shutting down
+func (s *BotServiceOp) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	callback, status, err := s.parseCallback(rw, req)
+	if err != nil {
+		s.reject(rw, req, status, err)
+		return
+	}
+
+	var key string
+	if s.Dedupe != nil {
+		key = DedupeKey(callback)
+		if s.Dedupe.Seen(key) {
+			rw.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if err := s.dispatch(callback); err != nil {
+		if s.Dedupe != nil {
+			// let Sendbird's retry through
+			s.Dedupe.Forget(key)
+		}
+		s.reject(rw, req, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// parseCallback validates and decodes a callback request, returning the status to answer with on failure.
+func (s *BotServiceOp) parseCallback(rw http.ResponseWriter, req *http.Request) (*BotCallback, int, error) {
+	if req.Method != http.MethodPost {
+		rw.Header().Set("Allow", http.MethodPost)
+		return nil, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", req.Method)
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("unsupported content type %q", req.Header.Get("Content-Type"))
+	}
+
+	limit := s.MaxBodyBytes
+	if limit <= 0 {
+		limit = DefaultMaxCallbackBytes
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(rw, req.Body, limit))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("body larger than %d bytes", limit)
+		}
+		return nil, http.StatusBadRequest, fmt.Errorf("reading body: %v", err)
+	}
+
+	signed, err := s.verifySignature(req.Header, body)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+
+	callback := new(BotCallback)
+	if err := json.Unmarshal(body, callback); err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid callback json: %v", err)
+	}
+
+	if err := s.verifyBotToken(callback); err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	if !signed && s.TokenLookup == nil {
+		return nil, http.StatusUnauthorized, ErrUnverifiedCallback
+	}
+
+	return callback, http.StatusOK, nil
+}
+
+// reject answers a callback with status and the reason, and reports it to OnError.
+func (s *BotServiceOp) reject(rw http.ResponseWriter, req *http.Request, status int, err error) {
+	cbErr := &CallbackError{Status: status, Err: err}
+	if s.OnError != nil {
+		s.OnError(req, cbErr)
+	}
+	http.Error(rw, err.Error(), status)
+}
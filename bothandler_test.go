@@ -0,0 +1,78 @@
+package sendbird
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBotHandlerRejectsMalformedRequests(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	bot := c.Bot.(*BotServiceOp)
+	bot.MaxBodyBytes = 512
+	bot.MessageReceived = func(cb *BotCallback) {
+		t.Errorf("MessageReceived called for a rejected callback: %+v", cb)
+	}
+
+	var reported []error
+	bot.OnError = func(req *http.Request, err error) { reported = append(reported, err) }
+
+	cases := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"wrong method", "GET", "application/json", "", http.StatusMethodNotAllowed},
+		{"wrong content type", "POST", "text/plain", testCallback, http.StatusUnsupportedMediaType},
+		{"missing content type", "POST", "", testCallback, http.StatusUnsupportedMediaType},
+		{"too large", "POST", "application/json", `{"message": "` + strings.Repeat("a", 1024) + `"}`, http.StatusRequestEntityTooLarge},
+		{"invalid json", "POST", "application/json; charset=utf-8", `{"message": `, http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(tc.method, "/sendbird_bot", strings.NewReader(tc.body))
+		if tc.contentType != "" {
+			req.Header.Set("Content-Type", tc.contentType)
+		}
+		rec := httptest.NewRecorder()
+
+		// the handler must answer, never exit the process
+		bot.ServeHTTP(rec, req)
+
+		if rec.Code != tc.status {
+			t.Errorf("%s: handler returned %d, expected %d", tc.name, rec.Code, tc.status)
+		}
+		if strings.TrimSpace(rec.Body.String()) == "" {
+			t.Errorf("%s: handler returned no reason", tc.name)
+		}
+	}
+
+	if len(reported) != len(cases) {
+		t.Fatalf("OnError called %d times, expected %d", len(reported), len(cases))
+	}
+
+	var cbErr *CallbackError
+	if !errors.As(reported[0], &cbErr) || cbErr.Status != http.StatusMethodNotAllowed {
+		t.Errorf("OnError got %v, expected a *CallbackError with status %d", reported[0], http.StatusMethodNotAllowed)
+	}
+}
+
+func TestBotServiceIsHTTPHandler(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+
+	var _ http.Handler = c.Bot
+
+	req := httptest.NewRequest("POST", "/sendbird_bot", strings.NewReader(testCallback))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, sign(testCallback, "SENDBIRD_API_TOKEN"))
+	rec := httptest.NewRecorder()
+	c.Bot.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("handler returned %d, expected %d", rec.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,258 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BotEvent is a bot callback being handled by a BotRouter.
+type BotEvent struct {
+	Context  context.Context
+	Callback *BotCallback
+
+	// Command matched by the route, e.g. "/remind", empty for mention, channel type and default routes
+	Command string
+
+	// Arguments named in the command pattern, e.g. Args["when"] for "/remind <when> <text>"
+	Args map[string]string
+
+	// Words of the message following the command
+	Fields []string
+
+	// Conversation state of the sender, nil when the router has no session store. Changes are saved once the
+	// handler returns; an empty session is deleted.
+	Session *Session
+
+	bots BotService
+}
+
+// Arg returns the named argument of the command pattern, or "" when it is absent.
+func (e *BotEvent) Arg(name string) string {
+	return e.Args[name]
+}
+
+// Reply sends message as the bot to the channel the callback came from.
+func (e *BotEvent) Reply(message string) (*BotMessage, error) {
+	return e.ReplyWithData(message, "")
+}
+
+// ReplyWithData sends message and custom data as the bot to the channel the callback came from.
+func (e *BotEvent) ReplyWithData(message, data string) (*BotMessage, error) {
+	params := &BotMessageRequest{
+		Message:    message,
+		Data:       data,
+		ChannelUrl: e.Callback.ChannelURl,
+	}
+	msg, _, err := e.bots.SendMessageWithContext(e.Context, e.Callback.BotUserId, params)
+	return msg, err
+}
+
+// IsMentioned reports whether the bot receiving the callback is mentioned in it.
+func (e *BotEvent) IsMentioned() bool {
+	for _, username := range e.Callback.Mentioned {
+		if username == e.Callback.BotUserId {
+			return true
+		}
+	}
+	return false
+}
+
+// BotHandlerFunc handles a routed bot callback.
+type BotHandlerFunc func(e *BotEvent) error
+
+// BotMiddleware wraps the handlers of a BotRouter, e.g. to log, authorize or recover.
+type BotMiddleware func(next BotHandlerFunc) BotHandlerFunc
+
+type botRoute struct {
+	match   func(e *BotEvent) bool
+	handler BotHandlerFunc
+}
+
+// BotRouter routes bot callbacks to handlers registered for commands, mentions and channel types. Routes are
+// tried in the order they were registered and the first match wins; callbacks matching no route go to the
+// default handler. Install it with
+//
+//	bots.MessageReceived = router.HandleCallback
+type BotRouter struct {
+	// Optional function told about errors returned by handlers
+	OnError func(e *BotEvent, err error)
+
+	// Optional store of sessions, loaded into BotEvent.Session for every callback
+	Sessions SessionStore
+
+	bots       BotService
+	routes     []botRoute
+	middleware []BotMiddleware
+	fallback   BotHandlerFunc
+}
+
+// NewBotRouter returns a router replying through bots.
+func NewBotRouter(bots BotService) *BotRouter {
+	return &BotRouter{bots: bots}
+}
+
+// Use appends middleware run around every handler, the default one included.
+func (r *BotRouter) Use(mw ...BotMiddleware) {
+	r.middleware = append(r.middleware, mw...)
+}
+
+// Command routes messages matching pattern to h. A pattern starts with the command and may be followed by
+// literal words and <name> placeholders, e.g. "/remind <when> <text>". Each placeholder takes one word, except
+// the last one which takes the rest of the message. Command panics on an invalid pattern.
+func (r *BotRouter) Command(pattern string, h BotHandlerFunc) {
+	tokens := strings.Fields(pattern)
+	if len(tokens) == 0 || !strings.HasPrefix(tokens[0], "/") || len(tokens[0]) < 2 {
+		panic(fmt.Sprintf("sendbird: invalid bot command pattern %q", pattern))
+	}
+	for _, tok := range tokens[1:] {
+		if strings.HasPrefix(tok, "<") != strings.HasSuffix(tok, ">") || tok == "<>" {
+			panic(fmt.Sprintf("sendbird: invalid placeholder %q in bot command pattern %q", tok, pattern))
+		}
+	}
+
+	r.routes = append(r.routes, botRoute{
+		match: func(e *BotEvent) bool {
+			return matchCommand(tokens, e)
+		},
+		handler: h,
+	})
+}
+
+// Mention routes messages mentioning the bot to h.
+func (r *BotRouter) Mention(h BotHandlerFunc) {
+	r.routes = append(r.routes, botRoute{
+		match:   (*BotEvent).IsMentioned,
+		handler: h,
+	})
+}
+
+// ChannelType routes messages sent in channels of the given type, e.g. "messaging" or "group_messaging", to h.
+func (r *BotRouter) ChannelType(channelType string, h BotHandlerFunc) {
+	r.routes = append(r.routes, botRoute{
+		match: func(e *BotEvent) bool {
+			return e.Callback.ChannelType == channelType
+		},
+		handler: h,
+	})
+}
+
+// Step routes messages of senders whose session is at step to h, letting a handler drive a dialog by setting
+// the session's next step. Register steps before commands to keep a dialog going whatever the message says.
+func (r *BotRouter) Step(step string, h BotHandlerFunc) {
+	r.routes = append(r.routes, botRoute{
+		match: func(e *BotEvent) bool {
+			return e.Session != nil && e.Session.Step == step
+		},
+		handler: h,
+	})
+}
+
+// Default sets the handler for messages matching no route. Such messages are ignored when it is not set.
+func (r *BotRouter) Default(h BotHandlerFunc) {
+	r.fallback = h
+}
+
+// HandleCallback routes a callback. It has the signature of a MessageReceivedHandler.
+func (r *BotRouter) HandleCallback(callback *BotCallback) {
+	r.Route(context.Background(), callback)
+}
+
+// Route routes a callback with ctx and returns the error of the handler it was routed to, or of loading or
+// saving its session.
+func (r *BotRouter) Route(ctx context.Context, callback *BotCallback) error {
+	e := &BotEvent{
+		Context:  ctx,
+		Callback: callback,
+		Args:     map[string]string{},
+		bots:     r.bots,
+	}
+
+	if r.Sessions != nil {
+		session, err := r.Sessions.Load(ctx, SessionKeyOf(callback))
+		if err != nil {
+			return r.fail(e, fmt.Errorf("sendbird: loading bot session: %v", err))
+		}
+		e.Session = session
+	}
+
+	h := r.fallback
+	for _, route := range r.routes {
+		if route.match(e) {
+			h = route.handler
+			break
+		}
+		// a failed command match may have filled some arguments
+		e.Command, e.Args, e.Fields = "", map[string]string{}, nil
+	}
+	if h == nil {
+		return nil
+	}
+
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		h = r.middleware[i](h)
+	}
+
+	err := h(e)
+	if err == nil && e.Session != nil {
+		err = r.saveSession(e)
+	}
+	if err != nil {
+		return r.fail(e, err)
+	}
+	return nil
+}
+
+// saveSession stores the session of an event, or deletes it when the handler emptied it.
+func (r *BotRouter) saveSession(e *BotEvent) error {
+	var err error
+	if e.Session.IsEmpty() {
+		err = r.Sessions.Delete(e.Context, e.Session.Key)
+	} else {
+		err = r.Sessions.Save(e.Context, e.Session)
+	}
+	if err != nil {
+		return fmt.Errorf("sendbird: saving bot session: %v", err)
+	}
+	return nil
+}
+
+// fail reports err to OnError and returns it.
+func (r *BotRouter) fail(e *BotEvent, err error) error {
+	if r.OnError != nil {
+		r.OnError(e, err)
+	}
+	return err
+}
+
+// matchCommand matches the message of e against a command pattern, filling the event's command and arguments.
+func matchCommand(pattern []string, e *BotEvent) bool {
+	words := strings.Fields(e.Callback.Message)
+	if len(words) == 0 || !strings.EqualFold(words[0], pattern[0]) {
+		return false
+	}
+
+	e.Command = pattern[0]
+	e.Fields = words[1:]
+
+	params := pattern[1:]
+	for i, param := range params {
+		if i >= len(e.Fields) {
+			return false
+		}
+		if !strings.HasPrefix(param, "<") {
+			if !strings.EqualFold(param, e.Fields[i]) {
+				return false
+			}
+			continue
+		}
+
+		name := param[1 : len(param)-1]
+		if i == len(params)-1 {
+			e.Args[name] = strings.Join(e.Fields[i:], " ")
+		} else {
+			e.Args[name] = e.Fields[i]
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package sendbird
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBotRouterCommands(t *testing.T) {
+	router := NewBotRouter(nil)
+
+	var got []string
+	router.Command("/help", func(e *BotEvent) error {
+		got = append(got, "help")
+		return nil
+	})
+	router.Command("/remind <when> <text>", func(e *BotEvent) error {
+		got = append(got, fmt.Sprintf("remind %s: %s", e.Arg("when"), e.Arg("text")))
+		return nil
+	})
+	router.Command("/config set <key> <value>", func(e *BotEvent) error {
+		got = append(got, fmt.Sprintf("set %s=%s", e.Arg("key"), e.Arg("value")))
+		return nil
+	})
+	router.Mention(func(e *BotEvent) error {
+		got = append(got, "mention")
+		return nil
+	})
+	router.ChannelType("group_messaging", func(e *BotEvent) error {
+		got = append(got, "group")
+		return nil
+	})
+	router.Default(func(e *BotEvent) error {
+		got = append(got, "default")
+		return nil
+	})
+
+	for _, cb := range []*BotCallback{
+		{Message: "/help"},
+		{Message: "/HELP me"},
+		{Message: "/remind tomorrow  buy some milk"},
+		{Message: "/remind"},
+		{Message: "/config set color blue"},
+		{Message: "/config get color"},
+		{Message: "hi @bot", BotUserId: "bot", Mentioned: []string{"john", "bot"}},
+		{Message: "hello all", ChannelType: "group_messaging"},
+		{Message: "hello", ChannelType: "messaging"},
+	} {
+		router.HandleCallback(cb)
+	}
+
+	expected := []string{
+		"help",
+		"help",
+		"remind tomorrow: buy some milk",
+		"default",
+		"set color=blue",
+		"default",
+		"mention",
+		"group",
+		"default",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("router dispatched %q, expected %q", got, expected)
+	}
+}
+
+func TestBotRouterMiddlewareAndErrors(t *testing.T) {
+	router := NewBotRouter(nil)
+
+	var order []string
+	router.Use(func(next BotHandlerFunc) BotHandlerFunc {
+		return func(e *BotEvent) error {
+			order = append(order, "middleware "+e.Command)
+			return next(e)
+		}
+	})
+
+	failure := errors.New("failed")
+	router.Command("/fail", func(e *BotEvent) error { return failure })
+
+	var reported error
+	router.OnError = func(e *BotEvent, err error) { reported = err }
+
+	router.HandleCallback(&BotCallback{Message: "/fail"})
+	router.HandleCallback(&BotCallback{Message: "ignored, no default"})
+
+	if !reflect.DeepEqual(order, []string{"middleware /fail"}) {
+		t.Errorf("middleware ran %q", order)
+	}
+
+	if reported != failure {
+		t.Errorf("OnError got %v, expected %v", reported, failure)
+	}
+}
+
+func TestBotRouterInvalidPattern(t *testing.T) {
+	for _, pattern := range []string{"", "help", "/", "/remind <when", "/remind <>"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Command(%q) should panic", pattern)
+				}
+			}()
+			NewBotRouter(nil).Command(pattern, func(e *BotEvent) error { return nil })
+		}()
+	}
+}
+
+func TestBotEventReply(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent BotMessageRequest
+	mux.HandleFunc("/v2/bots/helper_bot/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		json.NewDecoder(r.Body).Decode(&sent)
+		fmt.Fprintf(w, `{"bot_userid": "helper_bot", "channel_url": %q, "message": %q}`, sent.ChannelUrl, sent.Message)
+	})
+
+	router := NewBotRouter(client.Bot)
+	router.Command("/echo <text>", func(e *BotEvent) error {
+		msg, err := e.Reply(e.Arg("text"))
+		if err == nil && msg.Message != "hello there" {
+			t.Errorf("Reply returned %+v", msg)
+		}
+		return err
+	})
+
+	err := router.Route(context.Background(), &BotCallback{BotUserId: "helper_bot", ChannelURl: "channel_1", Message: "/echo hello there"})
+	if err != nil {
+		t.Fatalf("Route returned error: %v", err)
+	}
+
+	if sent.ChannelUrl != "channel_1" || sent.Message != "hello there" {
+		t.Errorf("Reply sent %+v", sent)
+	}
+}
@@ -0,0 +1,173 @@
+package sendbird
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// BotRegistration describes a bot hosted by a BotServer.
+type BotRegistration struct {
+	BotUserId     string
+	BotNickname   string
+	IsPrivacyMode bool
+
+	// Token of the bot, used to verify its callbacks. Filled in by BotServer.Register.
+	BotToken string
+
+	// Handler receiving the bot's callbacks, e.g. BotRouter.HandleCallback
+	Handler MessageReceivedHandler
+}
+
+// BotServer hosts several bots on one callback endpoint. Callbacks are routed to the handler of the bot they are
+// addressed to, after checking their bot_token against that bot's token.
+type BotServer struct {
+	// Public URL at which Sendbird reaches the server, set as callback URL of every bot by Register
+	CallbackURL string
+
+	// Handler receiving the callbacks. OnError, MaxBodyBytes and Dispatcher can be configured on it, a custom
+	// Dispatcher delivering to HandleCallback; TokenLookup and MessageReceived are owned by the server.
+	Callbacks *BotServiceOp
+
+	bots BotService
+
+	mu   sync.RWMutex
+	regs map[string]*BotRegistration
+}
+
+// NewBotServer returns a server for bots whose callbacks are sent to callbackURL.
+func NewBotServer(client *SendbirdClient, callbackURL string) *BotServer {
+	s := &BotServer{
+		CallbackURL: callbackURL,
+		bots:        client.Bot,
+		regs:        map[string]*BotRegistration{},
+	}
+	s.Callbacks = &BotServiceOp{
+		client:          client,
+		TokenLookup:     s.token,
+		MessageReceived: s.HandleCallback,
+	}
+	return s
+}
+
+// Handle adds a bot to the server, replacing any bot with the same user id. Its callbacks are accepted once its
+// token is known, either given in reg or filled in by Register.
+func (s *BotServer) Handle(reg BotRegistration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.regs[reg.BotUserId] = &reg
+}
+
+// Bot returns the registration of a bot, or false when the server doesn't host it.
+func (s *BotServer) Bot(botUserId string) (BotRegistration, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	reg, ok := s.regs[botUserId]
+	if !ok {
+		return BotRegistration{}, false
+	}
+	return *reg, true
+}
+
+// Register makes sure every hosted bot exists in Sendbird with the server's callback URL: missing bots are
+// created, bots whose nickname, privacy mode or callback URL differ are updated. The tokens returned by Sendbird
+// are kept to verify callbacks. Call it on startup.
+func (s *BotServer) Register(ctx context.Context) error {
+	existing, _, err := s.bots.ListWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	byId := make(map[string]Bot, len(existing))
+	for _, bot := range existing {
+		byId[bot.BotUserId] = bot
+	}
+
+	s.mu.RLock()
+	regs := make([]BotRegistration, 0, len(s.regs))
+	for _, reg := range s.regs {
+		regs = append(regs, *reg)
+	}
+	s.mu.RUnlock()
+
+	for _, reg := range regs {
+		bot, err := s.register(ctx, reg, byId)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if current, ok := s.regs[reg.BotUserId]; ok {
+			current.BotToken = bot.BotToken
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// register creates or updates a single bot.
+func (s *BotServer) register(ctx context.Context, reg BotRegistration, existing map[string]Bot) (*Bot, error) {
+	current, ok := existing[reg.BotUserId]
+	if !ok {
+		bot, _, err := s.bots.CreateWithContext(ctx, &BotRequest{
+			BotUserId:      reg.BotUserId,
+			BotNickname:    reg.BotNickname,
+			BotCallbackUrl: s.CallbackURL,
+			IsPrivacyMode:  reg.IsPrivacyMode,
+		})
+		return bot, err
+	}
+
+	if current.BotCallbackUrl == s.CallbackURL && current.BotNickname == reg.BotNickname &&
+		current.IsPrivacyMode == reg.IsPrivacyMode {
+		return &current, nil
+	}
+
+	bot, _, err := s.bots.UpdateWithContext(ctx, reg.BotUserId, &BotUpdateRequest{
+		BotNickname:    reg.BotNickname,
+		BotCallbackUrl: s.CallbackURL,
+		IsPrivacyMode:  reg.IsPrivacyMode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if bot.BotToken == "" {
+		bot.BotToken = current.BotToken
+	}
+	return bot, nil
+}
+
+// ServeHTTP receives the callbacks of every hosted bot, see BotServiceOp.ServeHTTP.
+func (s *BotServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	s.Callbacks.ServeHTTP(rw, req)
+}
+
+// Shutdown stops accepting callbacks and waits for the accepted ones to be handled.
+func (s *BotServer) Shutdown(ctx context.Context) error {
+	return s.Callbacks.Shutdown(ctx)
+}
+
+func (s *BotServer) token(botUserId string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	reg, ok := s.regs[botUserId]
+	if !ok {
+		return "", false
+	}
+	return reg.BotToken, true
+}
+
+// HandleCallback passes a verified callback to the handler of its bot. It has the signature of a
+// MessageReceivedHandler.
+func (s *BotServer) HandleCallback(callback *BotCallback) {
+	s.mu.RLock()
+	reg, ok := s.regs[callback.BotUserId]
+	s.mu.RUnlock()
+
+	if ok && reg.Handler != nil {
+		reg.Handler(callback)
+	}
+}
@@ -0,0 +1,115 @@
+package sendbird
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBotServerRegister(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const callbackURL = "https://example.com/sendbird_bot"
+
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[
+				{"bot_userid": "outdated_bot", "bot_token": "OUTDATED_TOKEN", "bot_nickname": "Outdated", "bot_callback_url": "https://old.example.com"},
+				{"bot_userid": "current_bot", "bot_token": "CURRENT_TOKEN", "bot_nickname": "Current", "bot_callback_url": "https://example.com/sendbird_bot"}
+			]`)
+		case "POST":
+			body := BotRequest{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.BotUserId != "new_bot" || body.BotCallbackUrl != callbackURL {
+				t.Errorf("Bot.Create received %+v", body)
+			}
+			fmt.Fprint(w, `{"bot_userid": "new_bot", "bot_token": "NEW_TOKEN"}`)
+		}
+	})
+
+	updates := 0
+	mux.HandleFunc("/v2/bots/outdated_bot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		updates++
+		body := BotUpdateRequest{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.BotCallbackUrl != callbackURL {
+			t.Errorf("Bot.Update received %+v", body)
+		}
+		fmt.Fprint(w, `{"bot_userid": "outdated_bot", "bot_token": "OUTDATED_TOKEN"}`)
+	})
+	mux.HandleFunc("/v2/bots/current_bot", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("an up to date bot should not be updated")
+	})
+
+	s := NewBotServer(client, callbackURL)
+	s.Handle(BotRegistration{BotUserId: "new_bot", BotNickname: "New"})
+	s.Handle(BotRegistration{BotUserId: "outdated_bot", BotNickname: "Outdated"})
+	s.Handle(BotRegistration{BotUserId: "current_bot", BotNickname: "Current"})
+
+	if err := s.Register(context.Background()); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	if updates != 1 {
+		t.Errorf("Bot.Update called %d times, expected 1", updates)
+	}
+
+	for id, token := range map[string]string{"new_bot": "NEW_TOKEN", "outdated_bot": "OUTDATED_TOKEN", "current_bot": "CURRENT_TOKEN"} {
+		if reg, _ := s.Bot(id); reg.BotToken != token {
+			t.Errorf("bot %s has token %q, expected %q", id, reg.BotToken, token)
+		}
+	}
+}
+
+func TestBotServerRoutesByBot(t *testing.T) {
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	s := NewBotServer(c, "https://example.com/sendbird_bot")
+	s.Callbacks.Dispatcher = NewBotDispatcher(s.HandleCallback, DispatcherConfig{Synchronous: true})
+
+	var got []string
+	handler := func(name string) MessageReceivedHandler {
+		return func(cb *BotCallback) { got = append(got, name+": "+cb.Message) }
+	}
+	s.Handle(BotRegistration{BotUserId: "helper_bot", BotToken: "BOT_TOKEN", Handler: handler("helper")})
+	s.Handle(BotRegistration{BotUserId: "other_bot", BotToken: "OTHER_TOKEN", Handler: handler("other")})
+
+	post := func(body string) int {
+		req := httptest.NewRequest("POST", "/sendbird_bot", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := post(testCallback); code != http.StatusOK {
+		t.Errorf("helper_bot callback answered %d, expected %d", code, http.StatusOK)
+	}
+
+	other := `{"bot_userid": "other_bot", "bot_token": "OTHER_TOKEN", "message": "yo"}`
+	if code := post(other); code != http.StatusOK {
+		t.Errorf("other_bot callback answered %d, expected %d", code, http.StatusOK)
+	}
+
+	// each bot is verified against its own token
+	crossed := `{"bot_userid": "other_bot", "bot_token": "BOT_TOKEN", "message": "forged"}`
+	if code := post(crossed); code != http.StatusUnauthorized {
+		t.Errorf("callback with another bot's token answered %d, expected %d", code, http.StatusUnauthorized)
+	}
+
+	unknown := `{"bot_userid": "unknown_bot", "bot_token": "BOT_TOKEN", "message": "hi"}`
+	if code := post(unknown); code != http.StatusUnauthorized {
+		t.Errorf("callback for an unknown bot answered %d, expected %d", code, http.StatusUnauthorized)
+	}
+
+	if expected := []string{"helper: hi", "other: yo"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("server routed %q, expected %q", got, expected)
+	}
+}
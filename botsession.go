@@ -0,0 +1,285 @@
+package sendbird
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultSessionTTL is how long a session is kept after its last save when a store is given no TTL.
+const DefaultSessionTTL = 30 * time.Minute
+
+// SessionKey identifies the conversation of a user with a bot in a channel.
+type SessionKey struct {
+	BotUserId      string `json:"bot_userid"`
+	ChannelUrl     string `json:"channel_url"`
+	SenderUsername string `json:"sender_username"`
+}
+
+// SessionKeyOf returns the key of the conversation a callback belongs to.
+func SessionKeyOf(callback *BotCallback) SessionKey {
+	return SessionKey{
+		BotUserId:      callback.BotUserId,
+		ChannelUrl:     callback.ChannelURl,
+		SenderUsername: callback.SenderUsername,
+	}
+}
+
+// Session holds the state of a conversation between messages, e.g. the step of a form and the answers given so
+// far.
+type Session struct {
+	Key       SessionKey        `json:"key"`
+	Step      string            `json:"step,omitempty"`
+	Values    map[string]string `json:"values,omitempty"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// Get returns a value of the session, or "" when it is absent.
+func (s *Session) Get(name string) string {
+	return s.Values[name]
+}
+
+// Set stores a value in the session.
+func (s *Session) Set(name, value string) {
+	if s.Values == nil {
+		s.Values = map[string]string{}
+	}
+	s.Values[name] = value
+}
+
+// Reset clears the step and values, ending the conversation. An empty session is deleted rather than saved.
+func (s *Session) Reset() {
+	s.Step = ""
+	s.Values = nil
+}
+
+// IsEmpty reports whether the session holds no step and no values.
+func (s *Session) IsEmpty() bool {
+	return s.Step == "" && len(s.Values) == 0
+}
+
+func (s *Session) clone() *Session {
+	c := *s
+	if s.Values != nil {
+		c.Values = make(map[string]string, len(s.Values))
+		for k, v := range s.Values {
+			c.Values[k] = v
+		}
+	}
+	return &c
+}
+
+// SessionStore persists sessions. Sessions not saved for longer than the store's TTL are expired.
+// Implementations must be safe for concurrent use.
+type SessionStore interface {
+	// Load returns the session stored for key, or a new empty session when there is none or it expired.
+	Load(ctx context.Context, key SessionKey) (*Session, error)
+
+	// Save stores a session, setting its UpdatedAt.
+	Save(ctx context.Context, session *Session) error
+
+	// Delete removes the session stored for key, if any.
+	Delete(ctx context.Context, key SessionKey) error
+}
+
+// MemorySessionStore is a SessionStore keeping sessions in memory.
+type MemorySessionStore struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	sessions  map[SessionKey]*Session
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+var _ SessionStore = &MemorySessionStore{}
+
+// NewMemorySessionStore returns a store keeping sessions in memory for ttl, DefaultSessionTTL when zero.
+func NewMemorySessionStore(ttl time.Duration) *MemorySessionStore {
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	return &MemorySessionStore{
+		ttl:      ttl,
+		sessions: map[SessionKey]*Session{},
+		now:      time.Now,
+	}
+}
+
+// Load returns a copy of the session stored for key, or a new empty session.
+func (s *MemorySessionStore) Load(ctx context.Context, key SessionKey) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[key]
+	if !ok || s.expired(session) {
+		delete(s.sessions, key)
+		return &Session{Key: key}, nil
+	}
+	return session.clone(), nil
+}
+
+// Save stores a copy of session.
+func (s *MemorySessionStore) Save(ctx context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	session.UpdatedAt = now
+	s.sessions[session.Key] = session.clone()
+
+	// drop expired sessions at most once per TTL
+	if now.Sub(s.lastSweep) >= s.ttl {
+		for key, stored := range s.sessions {
+			if s.expired(stored) {
+				delete(s.sessions, key)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
+
+// Delete removes the session stored for key.
+func (s *MemorySessionStore) Delete(ctx context.Context, key SessionKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, key)
+	return nil
+}
+
+// Len returns the number of sessions held, expired ones included until they are swept.
+func (s *MemorySessionStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.sessions)
+}
+
+func (s *MemorySessionStore) expired(session *Session) bool {
+	return s.now().Sub(session.UpdatedAt) >= s.ttl
+}
+
+// FileSessionStore is a SessionStore keeping each session in a JSON file of a directory, so that sessions
+// survive restarts.
+type FileSessionStore struct {
+	dir       string
+	ttl       time.Duration
+	mu        sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+var _ SessionStore = &FileSessionStore{}
+
+// NewFileSessionStore returns a store keeping sessions in dir for ttl, DefaultSessionTTL when zero. dir is
+// created when missing.
+func NewFileSessionStore(dir string, ttl time.Duration) (*FileSessionStore, error) {
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileSessionStore{dir: dir, ttl: ttl, now: time.Now}, nil
+}
+
+// Load reads the session stored for key, or returns a new empty session. Expired session files are removed.
+func (s *FileSessionStore) Load(ctx context.Context, key SessionKey) (*Session, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return &Session{Key: key}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	session := new(Session)
+	if err := json.Unmarshal(data, session); err != nil {
+		return nil, err
+	}
+
+	if s.now().Sub(session.UpdatedAt) >= s.ttl {
+		if err := s.Delete(ctx, key); err != nil {
+			return nil, err
+		}
+		return &Session{Key: key}, nil
+	}
+	return session, nil
+}
+
+// Save writes session to its file, replacing it atomically. At most once per TTL it also removes the files of
+// expired sessions, which would otherwise stay behind if never loaded again.
+func (s *FileSessionStore) Save(ctx context.Context, session *Session) error {
+	now := s.now()
+	session.UpdatedAt = now
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(s.dir, ".session-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	// a sweep must not remove a file renamed in after it was found expired
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Rename(tmp.Name(), s.path(session.Key)); err != nil {
+		return err
+	}
+	if now.Sub(s.lastSweep) >= s.ttl {
+		s.sweep(now)
+		s.lastSweep = now
+	}
+	return nil
+}
+
+// sweep removes the files of sessions expired at now. Files that can't be read or decoded are left alone.
+func (s *FileSessionStore) sweep(now time.Time) {
+	paths, _ := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		session := new(Session)
+		if json.Unmarshal(data, session) == nil && now.Sub(session.UpdatedAt) >= s.ttl {
+			os.Remove(path)
+		}
+	}
+}
+
+// Delete removes the file of the session stored for key.
+func (s *FileSessionStore) Delete(ctx context.Context, key SessionKey) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// path names session files after a hash of the key, since user ids and channel urls aren't safe file names.
+func (s *FileSessionStore) path(key SessionKey) string {
+	sum := sha256.Sum256([]byte(key.BotUserId + "\x00" + key.ChannelUrl + "\x00" + key.SenderUsername))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
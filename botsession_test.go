@@ -0,0 +1,167 @@
+package sendbird
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testSessionStore(t *testing.T, store SessionStore, advance func(time.Duration)) {
+	ctx := context.Background()
+	key := SessionKey{BotUserId: "helper_bot", ChannelUrl: "url", SenderUsername: "john"}
+
+	session, err := store.Load(ctx, key)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if session.Key != key || !session.IsEmpty() {
+		t.Errorf("Load returned %+v, expected an empty session for %+v", session, key)
+	}
+
+	session.Step = "name"
+	session.Set("size", "large")
+	if err := store.Save(ctx, session); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	// another sender in the same channel has their own session
+	other, _ := store.Load(ctx, SessionKey{BotUserId: "helper_bot", ChannelUrl: "url", SenderUsername: "jane"})
+	if !other.IsEmpty() {
+		t.Errorf("Load returned %+v for another sender, expected an empty session", other)
+	}
+
+	loaded, _ := store.Load(ctx, key)
+	if loaded.Step != "name" || !reflect.DeepEqual(loaded.Values, map[string]string{"size": "large"}) {
+		t.Errorf("Load returned %+v, expected the saved session", loaded)
+	}
+
+	advance(time.Minute - time.Second)
+	if loaded, _ := store.Load(ctx, key); loaded.IsEmpty() {
+		t.Errorf("session expired before its TTL")
+	}
+
+	advance(time.Second)
+	if loaded, _ := store.Load(ctx, key); !loaded.IsEmpty() {
+		t.Errorf("Load returned %+v after the TTL, expected an empty session", loaded)
+	}
+
+	store.Save(ctx, session)
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing session returned error: %v", err)
+	}
+	if loaded, _ := store.Load(ctx, key); !loaded.IsEmpty() {
+		t.Errorf("Load returned %+v after Delete, expected an empty session", loaded)
+	}
+}
+
+func TestMemorySessionStore(t *testing.T) {
+	store := NewMemorySessionStore(time.Minute)
+	now := time.Unix(1500000000, 0)
+	store.now = func() time.Time { return now }
+
+	testSessionStore(t, store, func(d time.Duration) { now = now.Add(d) })
+
+	// sessions handed out are copies
+	key := SessionKey{BotUserId: "helper_bot"}
+	session, _ := store.Load(context.Background(), key)
+	session.Set("a", "1")
+	store.Save(context.Background(), session)
+	session.Set("a", "2")
+	if loaded, _ := store.Load(context.Background(), key); loaded.Get("a") != "1" {
+		t.Errorf("unsaved change leaked into the store: %+v", loaded)
+	}
+}
+
+func TestFileSessionStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileSessionStore(dir, time.Minute)
+	if err != nil {
+		t.Fatalf("NewFileSessionStore returned error: %v", err)
+	}
+	now := time.Unix(1500000000, 0)
+	store.now = func() time.Time { return now }
+
+	testSessionStore(t, store, func(d time.Duration) { now = now.Add(d) })
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("store left %d files behind", len(entries))
+	}
+}
+
+func TestFileSessionStoreSweepsExpiredFiles(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileSessionStore(dir, time.Minute)
+	if err != nil {
+		t.Fatalf("NewFileSessionStore returned error: %v", err)
+	}
+	now := time.Unix(1500000000, 0)
+	store.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	stale := &Session{Key: SessionKey{BotUserId: "helper_bot", SenderUsername: "john"}}
+	if err := store.Save(ctx, stale); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	fresh := &Session{Key: SessionKey{BotUserId: "helper_bot", SenderUsername: "jane"}}
+	if err := store.Save(ctx, fresh); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	if _, err := os.Stat(store.path(stale.Key)); !os.IsNotExist(err) {
+		t.Errorf("expired session file was not swept: %v", err)
+	}
+	if _, err := os.Stat(store.path(fresh.Key)); err != nil {
+		t.Errorf("fresh session file is missing: %v", err)
+	}
+}
+
+func TestBotRouterSessions(t *testing.T) {
+	router := NewBotRouter(nil)
+	router.Sessions = NewMemorySessionStore(0)
+
+	var orders []string
+	router.Step("size", func(e *BotEvent) error {
+		e.Session.Set("size", e.Callback.Message)
+		e.Session.Step = "drink"
+		return nil
+	})
+	router.Step("drink", func(e *BotEvent) error {
+		orders = append(orders, e.Callback.SenderUsername+": "+e.Session.Get("size")+" "+e.Callback.Message)
+		e.Session.Reset()
+		return nil
+	})
+	router.Command("/order", func(e *BotEvent) error {
+		e.Session.Step = "size"
+		return nil
+	})
+
+	send := func(sender, message string) {
+		cb := &BotCallback{BotUserId: "helper_bot", ChannelURl: "url", SenderUsername: sender, Message: message}
+		if err := router.Route(context.Background(), cb); err != nil {
+			t.Fatalf("Route returned error: %v", err)
+		}
+	}
+
+	send("john", "/order")
+	send("jane", "/order")
+	send("john", "large")
+	send("jane", "small")
+	send("jane", "tea")
+	send("john", "coffee")
+	send("john", "coffee")
+
+	if expected := []string{"jane: small tea", "john: large coffee"}; !reflect.DeepEqual(orders, expected) {
+		t.Errorf("dialog produced %q, expected %q", orders, expected)
+	}
+	if n := router.Sessions.(*MemorySessionStore).Len(); n != 0 {
+		t.Errorf("store holds %d sessions after the dialogs ended, expected 0", n)
+	}
+}
@@ -1,5 +1,7 @@
 package sendbird
 
+import "context"
+
 type ChatChannelRequest struct {
 	RequestDefaults
 	ChannelUrl string `json:"channel_url,omitempty"` // Channel Url
@@ -45,14 +47,14 @@ type ChatChannelSetMetacounterRequest struct {
 }
 
 type ChatChannel struct {
-	Id            int    `json:"id"`
-	Name          string `json:"name"`
-	ChannelUrl    string `json:"channel_url"`
-	MemberCount   int    `json:"member_count"`
-	CoverUrl      string `json:"cover_url"`
-	CoverImageUrl string `json:"cover_image_url"` // used to handle json returning cover_url or cover_image_url
-	Data          string `json:"data"`
-	CreatedAt     int64  `json:"created_at"` // Epoch timestamp in milliseconds
+	Id            int         `json:"id"`
+	Name          string      `json:"name"`
+	ChannelUrl    string      `json:"channel_url"`
+	MemberCount   int         `json:"member_count"`
+	CoverUrl      string      `json:"cover_url"`
+	CoverImageUrl string      `json:"cover_image_url"` // used to handle json returning cover_url or cover_image_url
+	Data          string      `json:"data"`
+	CreatedAt     EpochMillis `json:"created_at"`
 }
 type ChatChannelUpdate struct {
 	ChatChannel
@@ -75,18 +77,31 @@ type MessageCount struct {
 // endpoints of the Sendbird API
 type ChatChannelService interface {
 	Create(*ChatChannelRequest) (*ChatChannel, *Response, error)
+	CreateWithContext(ctx context.Context, params *ChatChannelRequest) (*ChatChannel, *Response, error)
 	List() ([]ChatChannel, *Response, error)
+	ListWithContext(ctx context.Context) ([]ChatChannel, *Response, error)
 	Update(params *ChatChannelUpdateRequest) (*ChatChannelUpdate, *Response, error)
+	UpdateWithContext(ctx context.Context, params *ChatChannelUpdateRequest) (*ChatChannelUpdate, *Response, error)
 	Delete(channelUrl string) (*Response, error)
+	DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error)
 	View(channelUrl string) (*ChatChannelView, *Response, error)
+	ViewWithContext(ctx context.Context, channelUrl string) (*ChatChannelView, *Response, error)
 	Send(params *ChatChannelMessageRequest) (*Response, error)
+	SendWithContext(ctx context.Context, params *ChatChannelMessageRequest) (*Response, error)
 	GetMetadata(params *ChatChannelMetadataRequest) (map[string]string, *Response, error)
+	GetMetadataWithContext(ctx context.Context, params *ChatChannelMetadataRequest) (map[string]string, *Response, error)
 	SetMetadata(params *ChatChannelSetMetadataRequest) (map[string]string, *Response, error)
+	SetMetadataWithContext(ctx context.Context, params *ChatChannelSetMetadataRequest) (map[string]string, *Response, error)
 	GetMetacounter(params *ChatChannelMetacounterRequest) (map[string]int, *Response, error)
+	GetMetacounterWithContext(ctx context.Context, params *ChatChannelMetacounterRequest) (map[string]int, *Response, error)
 	SetMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	SetMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	IncreaseMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	IncreaseMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	DecreaseMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	DecreaseMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	MessageCount(channelUrl string) (*MessageCount, *Response, error)
+	MessageCountWithContext(ctx context.Context, channelUrl string) (*MessageCount, *Response, error)
 }
 
 // ChatChannelServiceOp handles communication with the Chat Channel related methods of
@@ -107,10 +122,19 @@ func mapCoverImageUrlToCoverUrl(chatChannels []ChatChannel) {
 
 // Create creates a channel using channel URL / name
 func (s *ChatChannelServiceOp) Create(params *ChatChannelRequest) (*ChatChannel, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) CreateWithContext(ctx context.Context, params *ChatChannelRequest) (*ChatChannel, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.Create")
 
 	path := "/channel/create"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	chatChannel := new(ChatChannel)
 	resp, err := s.client.Do(req, chatChannel)
@@ -124,11 +148,20 @@ func (s *ChatChannelServiceOp) Create(params *ChatChannelRequest) (*ChatChannel,
 
 // List retrieves the channel list
 func (s *ChatChannelServiceOp) List() ([]ChatChannel, *Response, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) ListWithContext(ctx context.Context) ([]ChatChannel, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.List")
 
 	path := "/channel/list"
 	params := &RequestDefaults{}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	chatChannels := []ChatChannel{}
 	resp, err := s.client.Do(req, &chatChannels)
@@ -144,10 +177,19 @@ func (s *ChatChannelServiceOp) List() ([]ChatChannel, *Response, error) {
 
 // Update updates a channel's information
 func (s *ChatChannelServiceOp) Update(params *ChatChannelUpdateRequest) (*ChatChannelUpdate, *Response, error) {
+	return s.UpdateWithContext(context.Background(), params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) UpdateWithContext(ctx context.Context, params *ChatChannelUpdateRequest) (*ChatChannelUpdate, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.Update")
 
 	path := "/channel/update"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	chatChannel := new(ChatChannelUpdate)
 	resp, err := s.client.Do(req, chatChannel)
@@ -163,12 +205,21 @@ func (s *ChatChannelServiceOp) Update(params *ChatChannelUpdateRequest) (*ChatCh
 
 // Delete deletes the channel that matches the URL
 func (s *ChatChannelServiceOp) Delete(channelUrl string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), channelUrl)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.Delete")
 
 	path := "/channel/delete"
 
 	params := ChatChannelRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -182,13 +233,22 @@ func (s *ChatChannelServiceOp) Delete(channelUrl string) (*Response, error) {
 
 // View returns the channel information and its online members.
 func (s *ChatChannelServiceOp) View(channelUrl string) (*ChatChannelView, *Response, error) {
+	return s.ViewWithContext(context.Background(), channelUrl)
+}
+
+// ViewWithContext is like View but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) ViewWithContext(ctx context.Context, channelUrl string) (*ChatChannelView, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.View")
 
 	path := "/channel/view"
 
 	params := ChatChannelRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
 
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	view := new(ChatChannelView)
 	resp, err := s.client.Do(req, view)
@@ -204,11 +264,20 @@ func (s *ChatChannelServiceOp) View(channelUrl string) (*ChatChannelView, *Respo
 
 // Send sends a message to the given channel
 func (s *ChatChannelServiceOp) Send(params *ChatChannelMessageRequest) (*Response, error) {
+	return s.SendWithContext(context.Background(), params)
+}
+
+// SendWithContext is like Send but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) SendWithContext(ctx context.Context, params *ChatChannelMessageRequest) (*Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.Send")
 
 	path := "/channel/send"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -222,11 +291,20 @@ func (s *ChatChannelServiceOp) Send(params *ChatChannelMessageRequest) (*Respons
 
 // GetMetadata gets values of metadata keys
 func (s *ChatChannelServiceOp) GetMetadata(params *ChatChannelMetadataRequest) (map[string]string, *Response, error) {
+	return s.GetMetadataWithContext(context.Background(), params)
+}
+
+// GetMetadataWithContext is like GetMetadata but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) GetMetadataWithContext(ctx context.Context, params *ChatChannelMetadataRequest) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.GetMetadata")
 
 	path := "/channel/get_metadata"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metadata := map[string]string{}
 	resp, err := s.client.Do(req, &metadata)
@@ -240,11 +318,20 @@ func (s *ChatChannelServiceOp) GetMetadata(params *ChatChannelMetadataRequest) (
 
 // SetMetadata sets values of metadata keys
 func (s *ChatChannelServiceOp) SetMetadata(params *ChatChannelSetMetadataRequest) (map[string]string, *Response, error) {
+	return s.SetMetadataWithContext(context.Background(), params)
+}
+
+// SetMetadataWithContext is like SetMetadata but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) SetMetadataWithContext(ctx context.Context, params *ChatChannelSetMetadataRequest) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.SetMetadata")
 
 	path := "/channel/set_metadata"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metadata := map[string]string{}
 	resp, err := s.client.Do(req, &metadata)
@@ -258,11 +345,20 @@ func (s *ChatChannelServiceOp) SetMetadata(params *ChatChannelSetMetadataRequest
 
 // GetMetacounter gets values of metacounter keys
 func (s *ChatChannelServiceOp) GetMetacounter(params *ChatChannelMetacounterRequest) (map[string]int, *Response, error) {
+	return s.GetMetacounterWithContext(context.Background(), params)
+}
+
+// GetMetacounterWithContext is like GetMetacounter but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) GetMetacounterWithContext(ctx context.Context, params *ChatChannelMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.GetMetacounter")
 
 	path := "/channel/get_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounters := map[string]int{}
 	resp, err := s.client.Do(req, &metacounters)
@@ -276,11 +372,20 @@ func (s *ChatChannelServiceOp) GetMetacounter(params *ChatChannelMetacounterRequ
 
 // SetMetacounter sets values of metacounter keys. All values should be integer
 func (s *ChatChannelServiceOp) SetMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.SetMetacounterWithContext(context.Background(), params)
+}
+
+// SetMetacounterWithContext is like SetMetacounter but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) SetMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.SetMetacounter")
 
 	path := "/channel/set_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounters := map[string]int{}
 	resp, err := s.client.Do(req, &metacounters)
@@ -294,11 +399,20 @@ func (s *ChatChannelServiceOp) SetMetacounter(params *ChatChannelSetMetacounterR
 
 // IncreaseMetacounter increases the values of metacounter keys. All deltas should be integer
 func (s *ChatChannelServiceOp) IncreaseMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.IncreaseMetacounterWithContext(context.Background(), params)
+}
+
+// IncreaseMetacounterWithContext is like IncreaseMetacounter but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) IncreaseMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.IncreaseMetacounter")
 
 	path := "/channel/incr_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounters := map[string]int{}
 	resp, err := s.client.Do(req, &metacounters)
@@ -312,11 +426,20 @@ func (s *ChatChannelServiceOp) IncreaseMetacounter(params *ChatChannelSetMetacou
 
 // DecreaseMetacounter decreases values of metacounter keys. All deltas should be integer
 func (s *ChatChannelServiceOp) DecreaseMetacounter(params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.DecreaseMetacounterWithContext(context.Background(), params)
+}
+
+// DecreaseMetacounterWithContext is like DecreaseMetacounter but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) DecreaseMetacounterWithContext(ctx context.Context, params *ChatChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.DecreaseMetacounter")
 
 	path := "/channel/decr_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounters := map[string]int{}
 	resp, err := s.client.Do(req, &metacounters)
@@ -330,12 +453,21 @@ func (s *ChatChannelServiceOp) DecreaseMetacounter(params *ChatChannelSetMetacou
 
 // MessageCount gets the message count of the channel
 func (s *ChatChannelServiceOp) MessageCount(channelUrl string) (*MessageCount, *Response, error) {
+	return s.MessageCountWithContext(context.Background(), channelUrl)
+}
+
+// MessageCountWithContext is like MessageCount but carries ctx through to the underlying HTTP request.
+func (s *ChatChannelServiceOp) MessageCountWithContext(ctx context.Context, channelUrl string) (*MessageCount, *Response, error) {
+	ctx = withOperation(ctx, "ChatChannel.MessageCount")
 
 	path := "/channel/message_count"
 
 	params := ChatChannelRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	count := new(MessageCount)
 	resp, err := s.client.Do(req, count)
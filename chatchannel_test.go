@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestChatChannelCreate(t *testing.T) {
@@ -28,7 +29,7 @@ func TestChatChannelCreate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -57,7 +58,7 @@ func TestChatChannelCreate(t *testing.T) {
 		MemberCount: 5,
 		CoverUrl:    "https://sendbird/images/cover_url.jpg",
 		Data:        "extra data",
-		CreatedAt:   1234567890123,
+		CreatedAt:   EpochMillis{time.UnixMilli(1234567890123)},
 	}
 
 	chatChannel, _, err := client.Chat.Create(&chatRequest)
@@ -84,7 +85,7 @@ func TestChatChannelList(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -123,7 +124,7 @@ func TestChatChannelList(t *testing.T) {
 			CoverUrl:      "https://sendbird/images/cover_url.jpg",
 			CoverImageUrl: "https://sendbird/images/cover_url.jpg",
 			Data:          "extra data",
-			CreatedAt:     1234567890123,
+			CreatedAt:     EpochMillis{time.UnixMilli(1234567890123)},
 		},
 		{
 			Id:            789,
@@ -133,7 +134,7 @@ func TestChatChannelList(t *testing.T) {
 			CoverUrl:      "https://sendbird/images/cover_url_2.jpg",
 			CoverImageUrl: "https://sendbird/images/cover_url_2.jpg",
 			Data:          "extra data 2",
-			CreatedAt:     3421353123553212,
+			CreatedAt:     EpochMillis{time.UnixMilli(3421353123553212)},
 		},
 	}
 
@@ -169,7 +170,7 @@ func TestChatChannelUpdate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -202,7 +203,7 @@ func TestChatChannelUpdate(t *testing.T) {
 			CoverUrl:      "https://sendbird/images/cover_url.jpg",
 			CoverImageUrl: "https://sendbird/images/cover_url.jpg",
 			Data:          "extra data",
-			CreatedAt:     1234567890123,
+			CreatedAt:     EpochMillis{time.UnixMilli(1234567890123)},
 		},
 		Ops: []string{"user_id_1", "user_id_2"},
 	}
@@ -231,7 +232,7 @@ func TestChatChannelDelete(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -265,7 +266,7 @@ func TestChatChannelView(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -306,7 +307,7 @@ func TestChatChannelView(t *testing.T) {
 			CoverUrl:      "http://sendbird.com/cover_image_url.jpg",
 			CoverImageUrl: "http://sendbird.com/cover_image_url.jpg",
 			Data:          "custom data",
-			CreatedAt:     12421521552,
+			CreatedAt:     EpochMillis{time.UnixMilli(12421521552)},
 		},
 		Members: []Member{
 			{
@@ -342,7 +343,7 @@ func TestChatChannelSend(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		if !reflect.DeepEqual(body, messageRequest) {
@@ -381,7 +382,7 @@ func TestChatChannelGetMetadata(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -432,7 +433,7 @@ func TestChatChannelSetMetadata(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -483,7 +484,7 @@ func TestChatChannelGetMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -534,7 +535,7 @@ func TestChatChannelSetMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -585,7 +586,7 @@ func TestChatChannelIncreaseMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -636,7 +637,7 @@ func TestChatChannelDecreaseMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -682,7 +683,7 @@ func TestChatChannelMessageCount(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
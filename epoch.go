@@ -0,0 +1,78 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// EpochMillis is a time encoded by the v3 API as Unix milliseconds, e.g. User.LastSeenAt. Zero and negative
+// values, which Sendbird uses for "never" or "now", decode to the zero time, and the zero time encodes as 0.
+type EpochMillis struct {
+	time.Time
+}
+
+// EpochSeconds is a time encoded by the v3 API as Unix seconds, e.g. UserV3.CreatedAt. It decodes and encodes
+// like EpochMillis.
+type EpochSeconds struct {
+	time.Time
+}
+
+// MarshalJSON encodes t as Unix milliseconds.
+func (t EpochMillis) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.UnixMilli(), 10)), nil
+}
+
+// UnmarshalJSON decodes Unix milliseconds.
+func (t *EpochMillis) UnmarshalJSON(data []byte) error {
+	v, err := decodeEpoch(data)
+	if err != nil {
+		return err
+	}
+	t.Time = time.Time{}
+	if v > 0 {
+		t.Time = time.UnixMilli(v)
+	}
+	return nil
+}
+
+// MarshalJSON encodes t as Unix seconds.
+func (t EpochSeconds) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// UnmarshalJSON decodes Unix seconds.
+func (t *EpochSeconds) UnmarshalJSON(data []byte) error {
+	v, err := decodeEpoch(data)
+	if err != nil {
+		return err
+	}
+	t.Time = time.Time{}
+	if v > 0 {
+		t.Time = time.Unix(v, 0)
+	}
+	return nil
+}
+
+// decodeEpoch decodes a JSON number, also accepted as a string. null decodes as 0.
+func decodeEpoch(data []byte) (int64, error) {
+	if string(data) == "null" {
+		return 0, nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return 0, err
+	}
+	if v, err := n.Int64(); err == nil {
+		return v, nil
+	}
+	f, err := n.Float64()
+	return int64(f), err
+}
@@ -0,0 +1,62 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEpochMillisJSON(t *testing.T) {
+	var v struct {
+		At EpochMillis `json:"at"`
+	}
+
+	for _, tt := range []struct {
+		in       string
+		expected time.Time
+	}{
+		{`{"at": 1542123999123}`, time.UnixMilli(1542123999123)},
+		{`{"at": "1542123999123"}`, time.UnixMilli(1542123999123)},
+		{`{"at": 0}`, time.Time{}},
+		{`{"at": -1}`, time.Time{}},
+		{`{"at": null}`, time.Time{}},
+	} {
+		v.At = EpochMillis{time.Now()}
+		if err := json.Unmarshal([]byte(tt.in), &v); err != nil {
+			t.Errorf("decoding %s: %v", tt.in, err)
+			continue
+		}
+		if !v.At.Equal(tt.expected) {
+			t.Errorf("decoding %s = %v, expected %v", tt.in, v.At, tt.expected)
+		}
+	}
+
+	v.At = EpochMillis{time.UnixMilli(1542123999123)}
+	if data, _ := json.Marshal(v); string(data) != `{"at":1542123999123}` {
+		t.Errorf("encoding = %s", data)
+	}
+	v.At = EpochMillis{}
+	if data, _ := json.Marshal(v); string(data) != `{"at":0}` {
+		t.Errorf("encoding the zero time = %s", data)
+	}
+
+	if err := json.Unmarshal([]byte(`{"at": "soon"}`), &v); err == nil {
+		t.Errorf("decoding a string that isn't a number succeeded")
+	}
+}
+
+func TestEpochSecondsJSON(t *testing.T) {
+	var v struct {
+		At EpochSeconds `json:"at"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"at": 1542123432}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if !v.At.Equal(time.Unix(1542123432, 0)) {
+		t.Errorf("decoding = %v", v.At)
+	}
+	if data, _ := json.Marshal(v); string(data) != `{"at":1542123432}` {
+		t.Errorf("encoding = %s", data)
+	}
+}
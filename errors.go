@@ -0,0 +1,99 @@
+package sendbird
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sendbird error codes returned in the "code" field of error bodies.
+const (
+	ErrCodeResourceNotFound   = 400201
+	ErrCodeUserNotFound       = 400301
+	ErrCodeInvalidAccessToken = 400302
+	ErrCodeInvalidAPIToken    = 400401
+	ErrCodeRateLimitExceeded  = 500910
+)
+
+// Sentinel errors matched by ErrorResponse through errors.Is.
+var (
+	ErrNotFound        = errors.New("sendbird: not found")
+	ErrUserNotFound    = errors.New("sendbird: user not found")
+	ErrChannelNotFound = errors.New("sendbird: channel not found")
+	ErrUnauthorized    = errors.New("sendbird: unauthorized")
+	ErrRateLimited     = errors.New("sendbird: rate limited")
+)
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (r *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return r.notFound()
+	case ErrUserNotFound:
+		return r.Code == ErrCodeUserNotFound || r.notFound() && isUserFamily(r.family())
+	case ErrChannelNotFound:
+		return r.notFound() && isChannelFamily(r.family())
+	case ErrUnauthorized:
+		return r.status() == http.StatusUnauthorized || r.status() == http.StatusForbidden ||
+			r.Code == ErrCodeInvalidAPIToken || r.Code == ErrCodeInvalidAccessToken
+	case ErrRateLimited:
+		return r.status() == http.StatusTooManyRequests || r.Code == ErrCodeRateLimitExceeded
+	}
+	return false
+}
+
+func (r *ErrorResponse) notFound() bool {
+	return r.status() == http.StatusNotFound || r.Code == ErrCodeResourceNotFound || r.Code == ErrCodeUserNotFound
+}
+
+func (r *ErrorResponse) status() int {
+	if r.Response == nil {
+		return 0
+	}
+	return r.Response.StatusCode
+}
+
+// family returns the endpoint family of the request that failed, as recorded by NewRequest.
+func (r *ErrorResponse) family() string {
+	if r.Response == nil || r.Response.Request == nil || r.Response.Request.URL == nil {
+		return ""
+	}
+	return RequestEndpointFamily(r.Response.Request)
+}
+
+func isUserFamily(family string) bool {
+	return family == EndpointUser || family == EndpointUsersV3
+}
+
+func isChannelFamily(family string) bool {
+	switch family {
+	case EndpointChannel, EndpointMessaging, EndpointGroupChannels, EndpointOpenChannels:
+		return true
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an API error for a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUserNotFound reports whether err is an API error for a missing user.
+func IsUserNotFound(err error) bool {
+	return errors.Is(err, ErrUserNotFound)
+}
+
+// IsChannelNotFound reports whether err is an API error for a missing channel.
+func IsChannelNotFound(err error) bool {
+	return errors.Is(err, ErrChannelNotFound)
+}
+
+// IsUnauthorized reports whether err is an API error caused by an invalid or missing token.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsRateLimited reports whether err comes from Sendbird throttling the call or from the client side rate limiter
+// failing fast.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrRateLimitExceeded)
+}
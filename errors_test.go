@@ -0,0 +1,127 @@
+package sendbird
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCheckResponseDecodesError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{"error": true, "message": "Channel not found.", "code": 400201}`
+	mux.HandleFunc("/channel/view", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, body)
+	})
+
+	_, _, err := client.Chat.View("missing")
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Chat.View returned %T, expected *ErrorResponse", err)
+	}
+
+	if errResp.Code != ErrCodeResourceNotFound || errResp.Message != "Channel not found." || !errResp.IsError {
+		t.Errorf("ErrorResponse = %+v", errResp)
+	}
+
+	if string(errResp.Body) != body {
+		t.Errorf("ErrorResponse.Body = %q, expected %q", errResp.Body, body)
+	}
+
+	if !IsNotFound(err) || !IsChannelNotFound(err) {
+		t.Errorf("expected a channel not found error, got %v", err)
+	}
+
+	if IsUserNotFound(err) || IsUnauthorized(err) || IsRateLimited(err) {
+		t.Errorf("unexpected predicate match for %v", err)
+	}
+}
+
+func TestCheckResponseNotFoundBehindPathPrefix(t *testing.T) {
+	setup()
+	defer teardown()
+
+	prefixed, _ := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithBaseURL(server.URL+"/sendbird/"))
+	mux.HandleFunc("/sendbird/v3/users/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": true, "message": "\"User\" not found.", "code": 400201}`)
+	})
+	mux.HandleFunc("/sendbird/v3/group_channels/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": true, "message": "\"Channel\" not found.", "code": 400201}`)
+	})
+
+	if _, _, err := prefixed.UsersV3.Get("missing"); !errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrChannelNotFound) {
+		t.Errorf("UserV3.Get returned %v, expected a user not found error", err)
+	}
+	if _, _, err := prefixed.GroupChannels.Get("missing"); !errors.Is(err, ErrChannelNotFound) || errors.Is(err, ErrUserNotFound) {
+		t.Errorf("GroupChannel.Get returned %v, expected a channel not found error", err)
+	}
+}
+
+func TestCheckResponseNotFoundWithoutBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Users.Auth(&UserRequest{Id: "123456"})
+
+	if !IsNotFound(err) || !IsUserNotFound(err) {
+		t.Errorf("expected a user not found error, got %v", err)
+	}
+
+	if errResp := err.(*ErrorResponse); errResp.Message == "" {
+		t.Errorf("ErrorResponse.Message should be populated for a bare 404")
+	}
+}
+
+func TestCheckResponseKeepsNonJSONBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>bad gateway</html>")
+	})
+
+	_, _, err := client.Admin.ConcurrentUserCount()
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Admin.ConcurrentUserCount returned %T, expected *ErrorResponse", err)
+	}
+
+	if string(errResp.Body) != "<html>bad gateway</html>" {
+		t.Errorf("ErrorResponse.Body = %q", errResp.Body)
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://api.sendbird.com/user/auth", nil)
+	newErr := func(status, code int) error {
+		return &ErrorResponse{Response: &http.Response{StatusCode: status, Request: req}, Code: code}
+	}
+
+	if !IsUserNotFound(newErr(400, ErrCodeUserNotFound)) {
+		t.Errorf("code %d should be a user not found error", ErrCodeUserNotFound)
+	}
+
+	if !IsUnauthorized(newErr(401, 0)) || !IsUnauthorized(newErr(400, ErrCodeInvalidAPIToken)) {
+		t.Errorf("401 and code %d should be unauthorized errors", ErrCodeInvalidAPIToken)
+	}
+
+	if !IsRateLimited(newErr(429, 0)) || !IsRateLimited(newErr(500, ErrCodeRateLimitExceeded)) {
+		t.Errorf("429 and code %d should be rate limit errors", ErrCodeRateLimitExceeded)
+	}
+
+	if !IsRateLimited(fmt.Errorf("wrapped: %w", ErrRateLimitExceeded)) {
+		t.Errorf("the client side limiter error should be a rate limit error")
+	}
+
+	if IsNotFound(newErr(500, 0)) || IsNotFound(errors.New("boom")) {
+		t.Errorf("unexpected not found match")
+	}
+}
@@ -0,0 +1,440 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GroupChannel is a group channel as returned by the Platform API v3.
+type GroupChannel struct {
+	ChannelUrl           string               `json:"channel_url"`
+	Name                 string               `json:"name"`
+	CoverUrl             string               `json:"cover_url"`
+	CustomType           string               `json:"custom_type"`
+	Data                 string               `json:"data"`
+	IsDistinct           bool                 `json:"is_distinct"`
+	IsPublic             bool                 `json:"is_public"`
+	IsSuper              bool                 `json:"is_super"`
+	IsEphemeral          bool                 `json:"is_ephemeral"`
+	IsAccessCodeRequired bool                 `json:"is_access_code_required"`
+	Freeze               bool                 `json:"freeze"`
+	MemberCount          int                  `json:"member_count"`
+	JoinedMemberCount    int                  `json:"joined_member_count"`
+	MaxLengthMessage     int                  `json:"max_length_message"`
+	UnreadMessageCount   int                  `json:"unread_message_count"`
+	LastMessage          *Message             `json:"last_message,omitempty"`
+	Members              []GroupChannelMember `json:"members,omitempty"` // only when requested, see GroupChannelListOptions.ShowMember
+	Operators            []UserV3             `json:"operators,omitempty"`
+	CreatedBy            *UserV3              `json:"created_by,omitempty"`
+	CreatedAt            EpochSeconds         `json:"created_at"`
+}
+
+// GroupChannelMember is a member of a group channel. State is "joined" or "invited", Role is "operator" or "".
+type GroupChannelMember struct {
+	UserV3
+	State   string `json:"state"`
+	Role    string `json:"role"`
+	IsMuted bool   `json:"is_muted"`
+}
+
+// GroupChannelList is a page of group channels. Next is the token of the following page, empty on the last one.
+type GroupChannelList struct {
+	Channels []GroupChannel `json:"channels"`
+	Next     string         `json:"next"`
+}
+
+type GroupChannelCreateRequest struct {
+	UserIds     []string `json:"user_ids"`
+	Name        string   `json:"name,omitempty"`
+	ChannelUrl  string   `json:"channel_url,omitempty"` // generated when empty
+	CoverUrl    string   `json:"cover_url,omitempty"`
+	CustomType  string   `json:"custom_type,omitempty"`
+	Data        string   `json:"data,omitempty"`
+	IsDistinct  bool     `json:"is_distinct,omitempty"`  // reuse the channel with exactly these members, if any
+	IsPublic    bool     `json:"is_public,omitempty"`    // anyone can join without an invitation
+	IsSuper     bool     `json:"is_super,omitempty"`     // allows thousands of members
+	IsEphemeral bool     `json:"is_ephemeral,omitempty"` // messages aren't stored
+	AccessCode  string   `json:"access_code,omitempty"`  // required to join a public channel
+	InviterId   string   `json:"inviter_id,omitempty"`
+	OperatorIds []string `json:"operator_ids,omitempty"`
+	Strict      bool     `json:"strict,omitempty"` // fail rather than skip missing users
+}
+
+// GroupChannelUpdateRequest updates a group channel. Empty fields are left unchanged.
+type GroupChannelUpdateRequest struct {
+	Name        string   `json:"name,omitempty"`
+	CoverUrl    string   `json:"cover_url,omitempty"`
+	CustomType  string   `json:"custom_type,omitempty"`
+	Data        string   `json:"data,omitempty"`
+	IsDistinct  *bool    `json:"is_distinct,omitempty"`
+	IsPublic    *bool    `json:"is_public,omitempty"`
+	AccessCode  string   `json:"access_code,omitempty"`
+	OperatorIds []string `json:"operator_ids,omitempty"`
+}
+
+type GroupChannelInviteRequest struct {
+	UserIds   []string `json:"user_ids"`
+	InviterId string   `json:"inviter_id,omitempty"`
+}
+
+type GroupChannelHideRequest struct {
+	UserId               string `json:"user_id"`
+	HidePreviousMessages bool   `json:"hide_previous_messages,omitempty"`
+	AllowAutoUnhide      *bool  `json:"allow_auto_unhide,omitempty"` // unhide on the next message, true by default
+}
+
+// GroupChannelResetHistoryRequest resets the chat history of a member, or of every member with ResetAll.
+type GroupChannelResetHistoryRequest struct {
+	UserId   string `json:"user_id,omitempty"`
+	ResetAll bool   `json:"reset_all,omitempty"`
+}
+
+// GroupChannelListOptions filters and paginates GroupChannelService.List. Zero fields are not sent.
+type GroupChannelListOptions struct {
+	Token            string   // page token, GroupChannelList.Next of the previous page
+	Limit            int      // channels per page, 1 to 100
+	ChannelUrls      []string // only these channels
+	MembersIncludeIn []string // channels with these members
+	QueryType        string   // "AND" for channels with all of MembersIncludeIn, "OR" for any of them
+	CustomTypes      []string // channels with one of these custom types
+	NameContains     string   // channels whose name contains this
+	DistinctMode     string   // "all", "distinct" or "nondistinct"
+	PublicMode       string   // "all", "private" or "public"
+	SuperMode        string   // "all", "super" or "nonsuper"
+	ShowEmpty        bool     // include channels without messages
+	ShowMember       bool     // include the members of each channel
+}
+
+// values encodes the options as query parameters.
+func (o *GroupChannelListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Token != "" {
+		v.Set("token", o.Token)
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if len(o.ChannelUrls) > 0 {
+		v.Set("channel_urls", strings.Join(o.ChannelUrls, ","))
+	}
+	if len(o.MembersIncludeIn) > 0 {
+		v.Set("members_include_in", strings.Join(o.MembersIncludeIn, ","))
+	}
+	if o.QueryType != "" {
+		v.Set("query_type", o.QueryType)
+	}
+	if len(o.CustomTypes) > 0 {
+		v.Set("custom_types", strings.Join(o.CustomTypes, ","))
+	}
+	if o.NameContains != "" {
+		v.Set("name_contains", o.NameContains)
+	}
+	if o.DistinctMode != "" {
+		v.Set("distinct_mode", o.DistinctMode)
+	}
+	if o.PublicMode != "" {
+		v.Set("public_mode", o.PublicMode)
+	}
+	if o.SuperMode != "" {
+		v.Set("super_mode", o.SuperMode)
+	}
+	if o.ShowEmpty {
+		v.Set("show_empty", "true")
+	}
+	if o.ShowMember {
+		v.Set("show_member", "true")
+	}
+	return v
+}
+
+// GroupChannelService is an interface for interfacing with the /v3/group_channels
+// endpoints of the Sendbird Platform API
+type GroupChannelService interface {
+	Create(params *GroupChannelCreateRequest) (*GroupChannel, *Response, error)
+	CreateWithContext(ctx context.Context, params *GroupChannelCreateRequest) (*GroupChannel, *Response, error)
+	List(opts *GroupChannelListOptions) (*GroupChannelList, *Response, error)
+	ListWithContext(ctx context.Context, opts *GroupChannelListOptions) (*GroupChannelList, *Response, error)
+	Get(channelUrl string) (*GroupChannel, *Response, error)
+	GetWithContext(ctx context.Context, channelUrl string) (*GroupChannel, *Response, error)
+	Update(channelUrl string, params *GroupChannelUpdateRequest) (*GroupChannel, *Response, error)
+	UpdateWithContext(ctx context.Context, channelUrl string, params *GroupChannelUpdateRequest) (*GroupChannel, *Response, error)
+	Delete(channelUrl string) (*Response, error)
+	DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error)
+	Invite(channelUrl string, params *GroupChannelInviteRequest) (*GroupChannel, *Response, error)
+	InviteWithContext(ctx context.Context, channelUrl string, params *GroupChannelInviteRequest) (*GroupChannel, *Response, error)
+	Leave(channelUrl string, userIds []string) (*Response, error)
+	LeaveWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error)
+	Hide(channelUrl string, params *GroupChannelHideRequest) (*Response, error)
+	HideWithContext(ctx context.Context, channelUrl string, params *GroupChannelHideRequest) (*Response, error)
+	Unhide(channelUrl string, userId string) (*Response, error)
+	UnhideWithContext(ctx context.Context, channelUrl string, userId string) (*Response, error)
+	AcceptInvitation(channelUrl string, userId string) (*GroupChannel, *Response, error)
+	AcceptInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*GroupChannel, *Response, error)
+	DeclineInvitation(channelUrl string, userId string) (*Response, error)
+	DeclineInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*Response, error)
+	ResetHistory(channelUrl string, params *GroupChannelResetHistoryRequest) (*Response, error)
+	ResetHistoryWithContext(ctx context.Context, channelUrl string, params *GroupChannelResetHistoryRequest) (*Response, error)
+}
+
+// GroupChannelServiceOp handles communication with the /v3/group_channels
+// related methods of the Sendbird Platform API.
+type GroupChannelServiceOp struct {
+	client *SendbirdClient
+}
+
+var _ GroupChannelService = &GroupChannelServiceOp{}
+
+// groupChannelPath returns the path of a group channel, followed by elems. An empty channel url would address the
+// collection instead, so it is refused.
+func groupChannelPath(channelUrl string, elems ...string) (string, error) {
+	if channelUrl == "" {
+		return "", fmt.Errorf("sendbird: channel url is empty")
+	}
+	return v3Path("group_channels", append([]string{channelUrl}, elems...)...), nil
+}
+
+// Create creates a group channel with its initial members
+func (s *GroupChannelServiceOp) Create(params *GroupChannelCreateRequest) (*GroupChannel, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) CreateWithContext(ctx context.Context, params *GroupChannelCreateRequest) (*GroupChannel, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Create")
+
+	channel := new(GroupChannel)
+	resp, err := s.client.call(ctx, "POST", v3Path("group_channels"), params, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
+
+// List returns a page of group channels matching opts, which may be nil
+func (s *GroupChannelServiceOp) List(opts *GroupChannelListOptions) (*GroupChannelList, *Response, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) ListWithContext(ctx context.Context, opts *GroupChannelListOptions) (*GroupChannelList, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.List")
+
+	path := v3Path("group_channels")
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	list := new(GroupChannelList)
+	resp, err := s.client.call(ctx, "GET", path, nil, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// Get retrieves a group channel along with its members
+func (s *GroupChannelServiceOp) Get(channelUrl string) (*GroupChannel, *Response, error) {
+	return s.GetWithContext(context.Background(), channelUrl)
+}
+
+// GetWithContext is like Get but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) GetWithContext(ctx context.Context, channelUrl string) (*GroupChannel, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Get")
+
+	path, err := groupChannelPath(channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel := new(GroupChannel)
+	resp, err := s.client.call(ctx, "GET", path+"?show_member=true", nil, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
+
+// Update updates a group channel
+func (s *GroupChannelServiceOp) Update(channelUrl string, params *GroupChannelUpdateRequest) (*GroupChannel, *Response, error) {
+	return s.UpdateWithContext(context.Background(), channelUrl, params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) UpdateWithContext(ctx context.Context, channelUrl string, params *GroupChannelUpdateRequest) (*GroupChannel, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Update")
+
+	path, err := groupChannelPath(channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel := new(GroupChannel)
+	resp, err := s.client.call(ctx, "PUT", path, params, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
+
+// Delete deletes a group channel
+func (s *GroupChannelServiceOp) Delete(channelUrl string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), channelUrl)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Delete")
+
+	path, err := groupChannelPath(channelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// Invite invites users to a group channel
+func (s *GroupChannelServiceOp) Invite(channelUrl string, params *GroupChannelInviteRequest) (*GroupChannel, *Response, error) {
+	return s.InviteWithContext(context.Background(), channelUrl, params)
+}
+
+// InviteWithContext is like Invite but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) InviteWithContext(ctx context.Context, channelUrl string, params *GroupChannelInviteRequest) (*GroupChannel, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Invite")
+
+	path, err := groupChannelPath(channelUrl, "invite")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel := new(GroupChannel)
+	resp, err := s.client.call(ctx, "POST", path, params, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
+
+// Leave makes members leave a group channel
+func (s *GroupChannelServiceOp) Leave(channelUrl string, userIds []string) (*Response, error) {
+	return s.LeaveWithContext(context.Background(), channelUrl, userIds)
+}
+
+// LeaveWithContext is like Leave but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) LeaveWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Leave")
+
+	path, err := groupChannelPath(channelUrl, "leave")
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		UserIds []string `json:"user_ids"`
+	}{userIds}
+	return s.client.call(ctx, "PUT", path, params, nil)
+}
+
+// Hide hides a group channel from a member's channel list
+func (s *GroupChannelServiceOp) Hide(channelUrl string, params *GroupChannelHideRequest) (*Response, error) {
+	return s.HideWithContext(context.Background(), channelUrl, params)
+}
+
+// HideWithContext is like Hide but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) HideWithContext(ctx context.Context, channelUrl string, params *GroupChannelHideRequest) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Hide")
+
+	path, err := groupChannelPath(channelUrl, "hide")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "PUT", path, params, nil)
+}
+
+// Unhide shows a hidden group channel in a member's channel list again
+func (s *GroupChannelServiceOp) Unhide(channelUrl string, userId string) (*Response, error) {
+	return s.UnhideWithContext(context.Background(), channelUrl, userId)
+}
+
+// UnhideWithContext is like Unhide but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) UnhideWithContext(ctx context.Context, channelUrl string, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.Unhide")
+
+	path, err := groupChannelPath(channelUrl, "hide")
+	if err != nil {
+		return nil, err
+	}
+
+	path += "?" + url.Values{"user_id": {userId}}.Encode()
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// AcceptInvitation makes an invited user join a group channel
+func (s *GroupChannelServiceOp) AcceptInvitation(channelUrl string, userId string) (*GroupChannel, *Response, error) {
+	return s.AcceptInvitationWithContext(context.Background(), channelUrl, userId)
+}
+
+// AcceptInvitationWithContext is like AcceptInvitation but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) AcceptInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*GroupChannel, *Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.AcceptInvitation")
+
+	path, err := groupChannelPath(channelUrl, "accept")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := struct {
+		UserId string `json:"user_id"`
+	}{userId}
+
+	channel := new(GroupChannel)
+	resp, err := s.client.call(ctx, "PUT", path, params, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
+
+// DeclineInvitation declines the invitation of a user to a group channel
+func (s *GroupChannelServiceOp) DeclineInvitation(channelUrl string, userId string) (*Response, error) {
+	return s.DeclineInvitationWithContext(context.Background(), channelUrl, userId)
+}
+
+// DeclineInvitationWithContext is like DeclineInvitation but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) DeclineInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.DeclineInvitation")
+
+	path, err := groupChannelPath(channelUrl, "decline")
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		UserId string `json:"user_id"`
+	}{userId}
+	return s.client.call(ctx, "PUT", path, params, nil)
+}
+
+// ResetHistory hides the messages sent so far from a member, or from every member
+func (s *GroupChannelServiceOp) ResetHistory(channelUrl string, params *GroupChannelResetHistoryRequest) (*Response, error) {
+	return s.ResetHistoryWithContext(context.Background(), channelUrl, params)
+}
+
+// ResetHistoryWithContext is like ResetHistory but carries ctx through to the underlying HTTP request.
+func (s *GroupChannelServiceOp) ResetHistoryWithContext(ctx context.Context, channelUrl string, params *GroupChannelResetHistoryRequest) (*Response, error) {
+	ctx = withOperation(ctx, "GroupChannel.ResetHistory")
+
+	path, err := groupChannelPath(channelUrl, "reset_user_history")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "PUT", path, params, nil)
+}
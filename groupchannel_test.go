@@ -0,0 +1,316 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const groupChannelResponse = `
+{
+    "channel_url": "sendbird_group_channel_1",
+    "name": "Saturday soccer",
+    "cover_url": "https://sendbird.com/main/img/cover/cover_08.jpg",
+    "custom_type": "team",
+    "data": "",
+    "is_distinct": true,
+    "is_public": false,
+    "is_super": false,
+    "is_ephemeral": false,
+    "member_count": 2,
+    "joined_member_count": 1,
+    "max_length_message": 5000,
+    "created_at": 1542123432,
+    "members": [
+        {"user_id": "john", "nickname": "John", "profile_url": "", "is_online": true, "last_seen_at": 0, "state": "joined", "role": "operator"},
+        {"user_id": "jane", "nickname": "Jane", "profile_url": "", "is_online": false, "last_seen_at": 1542123999123, "state": "invited", "role": ""}
+    ]
+}`
+
+var expectedGroupChannel = &GroupChannel{
+	ChannelUrl:        "sendbird_group_channel_1",
+	Name:              "Saturday soccer",
+	CoverUrl:          "https://sendbird.com/main/img/cover/cover_08.jpg",
+	CustomType:        "team",
+	IsDistinct:        true,
+	MemberCount:       2,
+	JoinedMemberCount: 1,
+	MaxLengthMessage:  5000,
+	CreatedAt:         EpochSeconds{time.Unix(1542123432, 0)},
+	Members: []GroupChannelMember{
+		{UserV3: UserV3{UserId: "john", Nickname: "John", IsOnline: true}, State: "joined", Role: "operator"},
+		{UserV3: UserV3{UserId: "jane", Nickname: "Jane", LastSeenAt: EpochMillis{time.UnixMilli(1542123999123)}}, State: "invited"},
+	},
+}
+
+func TestGroupChannelCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := GroupChannelCreateRequest{
+		UserIds:     []string{"john", "jane"},
+		Name:        "Saturday soccer",
+		CustomType:  "team",
+		IsDistinct:  true,
+		OperatorIds: []string{"john"},
+	}
+
+	mux.HandleFunc("/v3/group_channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		CheckForAuthContentType(t, r)
+		CheckForApiTokenHeader(t, r)
+
+		body := GroupChannelCreateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		if !reflect.DeepEqual(body, createRequest) {
+			t.Errorf("GroupChannel.Create API call received %+v, expected %+v", body, createRequest)
+		}
+
+		fmt.Fprint(w, groupChannelResponse)
+	})
+
+	channel, _, err := client.GroupChannels.Create(&createRequest)
+	if err != nil {
+		t.Errorf("GroupChannel.Create returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(channel, expectedGroupChannel) {
+		t.Errorf("GroupChannel.Create returned %+v, expected %+v", channel, expectedGroupChannel)
+	}
+}
+
+func TestGroupChannelList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+
+		expected := "custom_types=team%2Cclub&limit=20&members_include_in=john%2Cjane&name_contains=soccer&query_type=AND&show_member=true&token=NEXT_PAGE"
+		if r.URL.RawQuery != expected {
+			t.Errorf("GroupChannel.List query = %q, expected %q", r.URL.RawQuery, expected)
+		}
+
+		fmt.Fprintf(w, `{"channels": [%s], "next": "LAST_PAGE"}`, groupChannelResponse)
+	})
+
+	list, _, err := client.GroupChannels.List(&GroupChannelListOptions{
+		Token:            "NEXT_PAGE",
+		Limit:            20,
+		MembersIncludeIn: []string{"john", "jane"},
+		QueryType:        "AND",
+		CustomTypes:      []string{"team", "club"},
+		NameContains:     "soccer",
+		ShowMember:       true,
+	})
+	if err != nil {
+		t.Errorf("GroupChannel.List returned error: %v", err)
+	}
+
+	expected := &GroupChannelList{Channels: []GroupChannel{*expectedGroupChannel}, Next: "LAST_PAGE"}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("GroupChannel.List returned %+v, expected %+v", list, expected)
+	}
+}
+
+func TestGroupChannelGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+		if r.URL.Query().Get("show_member") != "true" {
+			t.Errorf("GroupChannel.Get didn't ask for the members")
+		}
+
+		fmt.Fprint(w, groupChannelResponse)
+	})
+
+	channel, _, err := client.GroupChannels.Get("sendbird_group_channel_1")
+	if err != nil {
+		t.Errorf("GroupChannel.Get returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(channel, expectedGroupChannel) {
+		t.Errorf("GroupChannel.Get returned %+v, expected %+v", channel, expectedGroupChannel)
+	}
+}
+
+func TestGroupChannelGetNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "\"Channel\" not found.", "code": 400201, "error": true}`)
+	})
+
+	_, _, err := client.GroupChannels.Get("missing")
+	if !IsChannelNotFound(err) {
+		t.Errorf("GroupChannel.Get returned %v, expected a channel not found error", err)
+	}
+}
+
+func TestGroupChannelUpdateAndDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	public := true
+	updateRequest := GroupChannelUpdateRequest{Name: "Sunday soccer", IsPublic: &public}
+
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+
+		if r.Method == "DELETE" {
+			fmt.Fprint(w, "{}")
+			return
+		}
+		testMethod(t, r, "PUT")
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		expected := map[string]interface{}{"name": "Sunday soccer", "is_public": true}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("GroupChannel.Update API call received %+v, expected %+v", body, expected)
+		}
+
+		fmt.Fprint(w, groupChannelResponse)
+	})
+
+	if _, _, err := client.GroupChannels.Update("sendbird_group_channel_1", &updateRequest); err != nil {
+		t.Errorf("GroupChannel.Update returned error: %v", err)
+	}
+	if _, err := client.GroupChannels.Delete("sendbird_group_channel_1"); err != nil {
+		t.Errorf("GroupChannel.Delete returned error: %v", err)
+	}
+}
+
+func TestGroupChannelMembership(t *testing.T) {
+	setup()
+	defer teardown()
+
+	bodies := map[string]map[string]interface{}{}
+	handle := func(action, method, response string) {
+		mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1/"+action, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, method)
+			CheckForApiTokenHeader(t, r)
+
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[action] = body
+
+			fmt.Fprint(w, response)
+		})
+	}
+	handle("invite", "POST", groupChannelResponse)
+	handle("leave", "PUT", "{}")
+	handle("accept", "PUT", groupChannelResponse)
+	handle("decline", "PUT", "{}")
+	handle("reset_user_history", "PUT", `{"ts_message_offset": 1542123999123}`)
+
+	url := "sendbird_group_channel_1"
+	if _, _, err := client.GroupChannels.Invite(url, &GroupChannelInviteRequest{UserIds: []string{"jane"}, InviterId: "john"}); err != nil {
+		t.Errorf("GroupChannel.Invite returned error: %v", err)
+	}
+	if _, _, err := client.GroupChannels.AcceptInvitation(url, "jane"); err != nil {
+		t.Errorf("GroupChannel.AcceptInvitation returned error: %v", err)
+	}
+	if _, err := client.GroupChannels.DeclineInvitation(url, "jack"); err != nil {
+		t.Errorf("GroupChannel.DeclineInvitation returned error: %v", err)
+	}
+	if _, err := client.GroupChannels.ResetHistory(url, &GroupChannelResetHistoryRequest{ResetAll: true}); err != nil {
+		t.Errorf("GroupChannel.ResetHistory returned error: %v", err)
+	}
+	if _, err := client.GroupChannels.Leave(url, []string{"john", "jane"}); err != nil {
+		t.Errorf("GroupChannel.Leave returned error: %v", err)
+	}
+
+	expected := map[string]map[string]interface{}{
+		"invite":             {"user_ids": []interface{}{"jane"}, "inviter_id": "john"},
+		"accept":             {"user_id": "jane"},
+		"decline":            {"user_id": "jack"},
+		"reset_user_history": {"reset_all": true},
+		"leave":              {"user_ids": []interface{}{"john", "jane"}},
+	}
+	if !reflect.DeepEqual(bodies, expected) {
+		t.Errorf("GroupChannel API calls received %+v, expected %+v", bodies, expected)
+	}
+}
+
+func TestGroupChannelHide(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1/hide", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+
+		switch r.Method {
+		case "PUT":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			expected := map[string]interface{}{"user_id": "john", "hide_previous_messages": true, "allow_auto_unhide": false}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("GroupChannel.Hide API call received %+v, expected %+v", body, expected)
+			}
+		case "DELETE":
+			if r.URL.Query().Get("user_id") != "john" {
+				t.Errorf("GroupChannel.Unhide query = %q, expected the user id", r.URL.RawQuery)
+			}
+		}
+		calls = append(calls, r.Method)
+		fmt.Fprint(w, "{}")
+	})
+
+	autoUnhide := false
+	if _, err := client.GroupChannels.Hide("sendbird_group_channel_1", &GroupChannelHideRequest{UserId: "john", HidePreviousMessages: true, AllowAutoUnhide: &autoUnhide}); err != nil {
+		t.Errorf("GroupChannel.Hide returned error: %v", err)
+	}
+	if _, err := client.GroupChannels.Unhide("sendbird_group_channel_1", "john"); err != nil {
+		t.Errorf("GroupChannel.Unhide returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(calls, []string{"PUT", "DELETE"}) {
+		t.Errorf("calls = %v, expected hide then unhide", calls)
+	}
+}
+
+func TestGroupChannelEmptyUrl(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s reached the server", r.Method, r.URL.Path)
+	})
+
+	if _, _, err := client.GroupChannels.Get(""); err == nil {
+		t.Errorf("GroupChannel.Get accepted an empty channel url")
+	}
+	if _, _, err := client.GroupChannels.Update("", &GroupChannelUpdateRequest{Name: "Lobby"}); err == nil {
+		t.Errorf("GroupChannel.Update accepted an empty channel url")
+	}
+	if _, err := client.GroupChannels.Delete(""); err == nil {
+		t.Errorf("GroupChannel.Delete accepted an empty channel url")
+	}
+	if _, err := client.GroupChannels.Leave("", []string{"john"}); err == nil {
+		t.Errorf("GroupChannel.Leave accepted an empty channel url")
+	}
+	if _, err := client.GroupChannels.Unhide("", "john"); err == nil {
+		t.Errorf("GroupChannel.Unhide accepted an empty channel url")
+	}
+}
@@ -0,0 +1,184 @@
+package sendbird
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// maximum amount of a body included in a log record, cut after redaction
+const maxLoggedBody = 64 << 10
+
+// logged in place of the bodies that can't be parsed, and so can't be redacted
+const unredactableBody = "[unredactable body omitted]"
+
+// sensitiveFields are the JSON fields and query parameters that carry credentials. Covers RequestDefaults.Auth,
+// RequestDefaultsAPIV2.ApiToken, user access tokens and bot tokens.
+var sensitiveFields = map[string]bool{
+	"auth":          true,
+	"api_token":     true,
+	"access_token":  true,
+	"bot_token":     true,
+	"session_token": true,
+	"token":         true,
+}
+
+// SetLogger sets the logger used to report every HTTP attempt made by Do. Summaries are logged at debug level,
+// failed attempts at warn level. A nil logger disables logging.
+func (c *SendbirdClient) SetLogger(l *slog.Logger) {
+	c.logger = l
+}
+
+// WithLogger sets the logger used to report API calls, see SetLogger.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *SendbirdClient) error {
+		c.SetLogger(l)
+		return nil
+	}
+}
+
+// WithBodyLogging adds the request and response bodies to debug log records. Credentials are always redacted.
+func WithBodyLogging() ClientOption {
+	return func(c *SendbirdClient) error {
+		c.logBodies = true
+		return nil
+	}
+}
+
+// RedactBody returns a copy of a JSON body with every credential field, at any depth, replaced by "REDACTED".
+// Bodies that aren't JSON are returned unchanged.
+func RedactBody(data []byte) []byte {
+	if out, ok := redactJSON(data); ok {
+		return out
+	}
+	return data
+}
+
+// redactJSON is like RedactBody but reports whether data was a single JSON value it could redact.
+func redactJSON(data []byte) ([]byte, bool) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		// trailing data after the JSON value
+		return nil, false
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return nil, false
+	}
+	return out, true
+}
+
+// loggedBody returns a body as it appears in log records: redacted, then cut to maxLoggedBody. Bodies that can't
+// be redacted are replaced by a placeholder, since they may carry credentials.
+func loggedBody(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+	out, ok := redactJSON(data)
+	if !ok {
+		return unredactableBody
+	}
+	if len(out) > maxLoggedBody {
+		out = out[:maxLoggedBody]
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if sensitiveFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(val)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
+
+// redactURL returns u as a string with credential query parameters redacted.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	changed := false
+	for k := range q {
+		if sensitiveFields[k] {
+			q.Set(k, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+
+	c := *u
+	c.RawQuery = q.Encode()
+	return c.String()
+}
+
+// logAttempt reports a single HTTP attempt. The response body, when logged, is restored for Do to decode.
+func (c *SendbirdClient) logAttempt(req *http.Request, resp *http.Response, err error, attempt int, elapsed time.Duration) {
+	ctx := req.Context()
+	level := slog.LevelDebug
+	if err != nil || resp.StatusCode >= 500 {
+		level = slog.LevelWarn
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", elapsed),
+	}
+	if op := OperationName(ctx); op != "" {
+		attrs = append(attrs, slog.String("operation", op))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+
+	if c.logBodies && c.logger.Enabled(ctx, slog.LevelDebug) {
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, _ := ioutil.ReadAll(body)
+				body.Close()
+				attrs = append(attrs, slog.String("request_body", loggedBody(data)))
+			}
+		}
+		if resp != nil && resp.Body != nil {
+			// read the whole body, a truncated one is no longer valid JSON and couldn't be redacted
+			data, _ := ioutil.ReadAll(resp.Body)
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+			attrs = append(attrs, slog.String("response_body", loggedBody(data)))
+		}
+	}
+
+	c.logger.LogAttrs(ctx, level, "sendbird request", attrs...)
+}
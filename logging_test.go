@@ -0,0 +1,129 @@
+package sendbird
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggerRedactsCredentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client.logBodies = true
+
+	mux.HandleFunc("/user/create", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "123456", "nickname": "nickname", "access_token": "SECRET_ACCESS_TOKEN"}`)
+	})
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"bot_userid": "helper_bot", "bot_token": "SECRET_BOT_TOKEN"}]`)
+	})
+
+	user, _, err := client.Users.Create(&UserRequest{Id: "123456", Nickname: "nickname"})
+	if err != nil {
+		t.Fatalf("User.Create returned error: %v", err)
+	}
+	if user.AccessToken != "SECRET_ACCESS_TOKEN" {
+		t.Errorf("logging must not alter the decoded response, got %+v", user)
+	}
+
+	if _, _, err := client.Bot.List(); err != nil {
+		t.Fatalf("Bot.List returned error: %v", err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{client.ApiToken, "SECRET_ACCESS_TOKEN", "SECRET_BOT_TOKEN"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output leaks %q:\n%s", secret, out)
+		}
+	}
+
+	for _, expected := range []string{`"operation":"User.Create"`, `"status":200`, `"request_body"`, `nickname`} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("log output is missing %s:\n%s", expected, out)
+		}
+	}
+}
+
+func TestLoggerSummaryOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 3}`)
+	})
+
+	if _, _, err := client.Admin.ConcurrentUserCount(); err != nil {
+		t.Fatalf("Admin.ConcurrentUserCount returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "sendbird request") || strings.Contains(out, "body") {
+		t.Errorf("unexpected log output:\n%s", out)
+	}
+}
+
+func TestLoggerRedactsLargeBodies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	client.SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client.logBodies = true
+
+	bots := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		bots = append(bots, fmt.Sprintf(`{"bot_userid": "bot_%d", "bot_nickname": "%s", "bot_token": "SECRET_BOT_TOKEN"}`, i, strings.Repeat("x", 64)))
+	}
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "["+strings.Join(bots, ",")+"]")
+	})
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "count=3&bot_token=SECRET_BOT_TOKEN")
+	})
+
+	list, _, err := client.Bot.List()
+	if err != nil {
+		t.Fatalf("Bot.List returned error: %v", err)
+	}
+	if len(list) != 1000 {
+		t.Errorf("Bot.List returned %d bots, expected 1000", len(list))
+	}
+
+	client.Admin.ConcurrentUserCount()
+
+	out := buf.String()
+	if strings.Contains(out, "SECRET_BOT_TOKEN") {
+		t.Errorf("log output leaks the bot token")
+	}
+	if !strings.Contains(out, "bot_0") || !strings.Contains(out, unredactableBody) {
+		t.Errorf("log output is missing the redacted bodies")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	in := `{"auth": "a", "id": "1", "nested": [{"api_token": "b", "bot_token": "c"}], "big": 12345678901234567890}`
+	out := string(RedactBody([]byte(in)))
+
+	for _, secret := range []string{`"a"`, `"b"`, `"c"`} {
+		if strings.Contains(out, secret) {
+			t.Errorf("RedactBody left %s in %s", secret, out)
+		}
+	}
+
+	if !strings.Contains(out, `"id":"1"`) || !strings.Contains(out, "12345678901234567890") {
+		t.Errorf("RedactBody altered other fields: %s", out)
+	}
+
+	if out := string(RedactBody([]byte("not json"))); out != "not json" {
+		t.Errorf("RedactBody(non-JSON) = %q", out)
+	}
+}
@@ -0,0 +1,277 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChannelType selects the channels a MessageService call applies to.
+type ChannelType string
+
+const (
+	ChannelTypeOpen  ChannelType = "open_channels"
+	ChannelTypeGroup ChannelType = "group_channels"
+)
+
+// Message types, see Message.Type.
+const (
+	MessageTypeUser  = "MESG"
+	MessageTypeAdmin = "ADMM"
+	MessageTypeFile  = "FILE"
+)
+
+// Message is a message of an open or group channel, as returned by the Platform API v3.
+type Message struct {
+	MessageId      int64        `json:"message_id"`
+	Type           string       `json:"type"` // MessageTypeUser, MessageTypeAdmin or MessageTypeFile
+	CustomType     string       `json:"custom_type"`
+	ChannelUrl     string       `json:"channel_url"`
+	ChannelType    string       `json:"channel_type"`   // "open" or "group"
+	User           *UserV3      `json:"user,omitempty"` // the sender, nil for admin messages
+	Message        string       `json:"message"`
+	Data           string       `json:"data"`
+	File           *MessageFile `json:"file,omitempty"` // only for file messages
+	MentionedUsers []UserV3     `json:"mentioned_users,omitempty"`
+	IsRemoved      bool         `json:"is_removed"`
+	CreatedAt      EpochMillis  `json:"created_at"`
+	UpdatedAt      EpochMillis  `json:"updated_at"` // zero when the message was never updated
+}
+
+// MessageFile is the file attached to a file message.
+type MessageFile struct {
+	Url  string `json:"url"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+	Data string `json:"data"`
+}
+
+// MessageSendRequest sends a message. User messages need UserId, admin messages are sent on behalf of the
+// application.
+type MessageSendRequest struct {
+	MessageType      string   `json:"message_type"` // MessageTypeUser or MessageTypeAdmin
+	UserId           string   `json:"user_id,omitempty"`
+	Message          string   `json:"message"`
+	CustomType       string   `json:"custom_type,omitempty"`
+	Data             string   `json:"data,omitempty"`
+	MentionedUserIds []string `json:"mentioned_user_ids,omitempty"`
+	SendPush         *bool    `json:"send_push,omitempty"` // true by default
+	IsSilent         bool     `json:"is_silent,omitempty"` // don't update the unread counts and last message
+}
+
+// MessageUpdateRequest updates a message. Empty fields are left unchanged.
+type MessageUpdateRequest struct {
+	MessageType      string   `json:"message_type"` // type of the updated message, MessageTypeUser when empty
+	Message          string   `json:"message,omitempty"`
+	CustomType       string   `json:"custom_type,omitempty"`
+	Data             string   `json:"data,omitempty"`
+	MentionedUserIds []string `json:"mentioned_user_ids,omitempty"`
+}
+
+// MessageListOptions selects the messages returned by MessageService.List: up to PrevLimit messages sent before
+// and NextLimit messages sent after a point, given as MessageTs or MessageId. When neither is set, messages are
+// listed around the current time.
+type MessageListOptions struct {
+	MessageTs   time.Time // list around this time
+	MessageId   int64     // list around this message, when MessageTs is zero
+	PrevLimit   int       // messages before the point, the API default when 0
+	NextLimit   int       // messages after the point, the API default when 0
+	Include     bool      // include the messages sent exactly at MessageTs or the message MessageId
+	Reverse     bool      // newest messages first
+	SenderId    string    // only the messages of this user
+	MessageType string    // only the messages of this type
+	CustomTypes []string  // only the messages with one of these custom types
+}
+
+// values encodes the options as query parameters.
+func (o *MessageListOptions) values(now time.Time) url.Values {
+	if o == nil {
+		o = &MessageListOptions{}
+	}
+
+	v := url.Values{}
+	switch {
+	case !o.MessageTs.IsZero():
+		v.Set("message_ts", strconv.FormatInt(o.MessageTs.UnixMilli(), 10))
+	case o.MessageId > 0:
+		v.Set("message_id", strconv.FormatInt(o.MessageId, 10))
+	default:
+		v.Set("message_ts", strconv.FormatInt(now.UnixMilli(), 10))
+	}
+	if o.PrevLimit > 0 {
+		v.Set("prev_limit", strconv.Itoa(o.PrevLimit))
+	}
+	if o.NextLimit > 0 {
+		v.Set("next_limit", strconv.Itoa(o.NextLimit))
+	}
+	if o.Include {
+		v.Set("include", "true")
+	}
+	if o.Reverse {
+		v.Set("reverse", "true")
+	}
+	if o.SenderId != "" {
+		v.Set("sender_id", o.SenderId)
+	}
+	if o.MessageType != "" {
+		v.Set("message_type", o.MessageType)
+	}
+	if len(o.CustomTypes) > 0 {
+		v.Set("custom_types", strings.Join(o.CustomTypes, ","))
+	}
+	return v
+}
+
+// MessageService is an interface for interfacing with the message endpoints
+// of open and group channels of the Sendbird Platform API
+type MessageService interface {
+	Send(channelType ChannelType, channelUrl string, params *MessageSendRequest) (*Message, *Response, error)
+	SendWithContext(ctx context.Context, channelType ChannelType, channelUrl string, params *MessageSendRequest) (*Message, *Response, error)
+	List(channelType ChannelType, channelUrl string, opts *MessageListOptions) ([]Message, *Response, error)
+	ListWithContext(ctx context.Context, channelType ChannelType, channelUrl string, opts *MessageListOptions) ([]Message, *Response, error)
+	Get(channelType ChannelType, channelUrl string, messageId int64) (*Message, *Response, error)
+	GetWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64) (*Message, *Response, error)
+	Update(channelType ChannelType, channelUrl string, messageId int64, params *MessageUpdateRequest) (*Message, *Response, error)
+	UpdateWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64, params *MessageUpdateRequest) (*Message, *Response, error)
+	Delete(channelType ChannelType, channelUrl string, messageId int64) (*Response, error)
+	DeleteWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64) (*Response, error)
+}
+
+// MessageServiceOp handles communication with the message related
+// methods of the Sendbird Platform API.
+type MessageServiceOp struct {
+	client *SendbirdClient
+}
+
+var _ MessageService = &MessageServiceOp{}
+
+// messagesPath returns the path of the messages of a channel.
+func messagesPath(channelType ChannelType, channelUrl string) (string, error) {
+	if channelType != ChannelTypeOpen && channelType != ChannelTypeGroup {
+		return "", fmt.Errorf("sendbird: invalid channel type %q", channelType)
+	}
+	return v3Path(string(channelType), channelUrl, "messages"), nil
+}
+
+// messagePath returns the path of a message of a channel.
+func messagePath(channelType ChannelType, channelUrl string, messageId int64) (string, error) {
+	if messageId <= 0 {
+		return "", fmt.Errorf("sendbird: invalid message id %d", messageId)
+	}
+	path, err := messagesPath(channelType, channelUrl)
+	if err != nil {
+		return "", err
+	}
+	return path + "/" + strconv.FormatInt(messageId, 10), nil
+}
+
+// Send sends a user or admin message to a channel and returns it
+func (s *MessageServiceOp) Send(channelType ChannelType, channelUrl string, params *MessageSendRequest) (*Message, *Response, error) {
+	return s.SendWithContext(context.Background(), channelType, channelUrl, params)
+}
+
+// SendWithContext is like Send but carries ctx through to the underlying HTTP request.
+func (s *MessageServiceOp) SendWithContext(ctx context.Context, channelType ChannelType, channelUrl string, params *MessageSendRequest) (*Message, *Response, error) {
+	ctx = withOperation(ctx, "Message.Send")
+
+	path, err := messagesPath(channelType, channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.callMessage(ctx, "POST", path, params)
+}
+
+// List returns the messages of a channel around a point in time or a message, see MessageListOptions. opts may
+// be nil.
+func (s *MessageServiceOp) List(channelType ChannelType, channelUrl string, opts *MessageListOptions) ([]Message, *Response, error) {
+	return s.ListWithContext(context.Background(), channelType, channelUrl, opts)
+}
+
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *MessageServiceOp) ListWithContext(ctx context.Context, channelType ChannelType, channelUrl string, opts *MessageListOptions) ([]Message, *Response, error) {
+	ctx = withOperation(ctx, "Message.List")
+
+	path, err := messagesPath(channelType, channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := struct {
+		Messages []Message `json:"messages"`
+	}{}
+	resp, err := s.client.call(ctx, "GET", path+"?"+opts.values(time.Now()).Encode(), nil, &list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list.Messages, resp, nil
+}
+
+// Get retrieves a message
+func (s *MessageServiceOp) Get(channelType ChannelType, channelUrl string, messageId int64) (*Message, *Response, error) {
+	return s.GetWithContext(context.Background(), channelType, channelUrl, messageId)
+}
+
+// GetWithContext is like Get but carries ctx through to the underlying HTTP request.
+func (s *MessageServiceOp) GetWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64) (*Message, *Response, error) {
+	ctx = withOperation(ctx, "Message.Get")
+
+	path, err := messagePath(channelType, channelUrl, messageId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.callMessage(ctx, "GET", path, nil)
+}
+
+// Update updates the text, data or custom type of a message
+func (s *MessageServiceOp) Update(channelType ChannelType, channelUrl string, messageId int64, params *MessageUpdateRequest) (*Message, *Response, error) {
+	return s.UpdateWithContext(context.Background(), channelType, channelUrl, messageId, params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *MessageServiceOp) UpdateWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64, params *MessageUpdateRequest) (*Message, *Response, error) {
+	ctx = withOperation(ctx, "Message.Update")
+
+	if params == nil {
+		return nil, nil, fmt.Errorf("sendbird: update of message %d is nil", messageId)
+	}
+	path, err := messagePath(channelType, channelUrl, messageId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	update := *params
+	if update.MessageType == "" {
+		update.MessageType = MessageTypeUser
+	}
+	return s.callMessage(ctx, "PUT", path, update)
+}
+
+// Delete deletes a message
+func (s *MessageServiceOp) Delete(channelType ChannelType, channelUrl string, messageId int64) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), channelType, channelUrl, messageId)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *MessageServiceOp) DeleteWithContext(ctx context.Context, channelType ChannelType, channelUrl string, messageId int64) (*Response, error) {
+	ctx = withOperation(ctx, "Message.Delete")
+
+	path, err := messagePath(channelType, channelUrl, messageId)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// callMessage is like call for the requests answered with a message.
+func (s *MessageServiceOp) callMessage(ctx context.Context, method, path string, body interface{}) (*Message, *Response, error) {
+	message := new(Message)
+	resp, err := s.client.call(ctx, method, path, body, message)
+	if err != nil {
+		return nil, resp, err
+	}
+	return message, resp, nil
+}
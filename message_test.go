@@ -0,0 +1,238 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const messageResponse = `
+{
+    "message_id": 7451268542,
+    "type": "MESG",
+    "custom_type": "greeting",
+    "channel_url": "sendbird_group_channel_1",
+    "channel_type": "group",
+    "user": {"user_id": "john", "nickname": "John", "profile_url": ""},
+    "message": "Hello",
+    "data": "extra data",
+    "mentioned_users": [],
+    "is_removed": false,
+    "created_at": 1542123999123,
+    "updated_at": 0
+}`
+
+var expectedMessage = &Message{
+	MessageId:      7451268542,
+	Type:           MessageTypeUser,
+	CustomType:     "greeting",
+	ChannelUrl:     "sendbird_group_channel_1",
+	ChannelType:    "group",
+	User:           &UserV3{UserId: "john", Nickname: "John"},
+	Message:        "Hello",
+	Data:           "extra data",
+	MentionedUsers: []UserV3{},
+	CreatedAt:      EpochMillis{time.UnixMilli(1542123999123)},
+}
+
+func TestMessageSend(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sendRequest := MessageSendRequest{
+		MessageType: MessageTypeUser,
+		UserId:      "john",
+		Message:     "Hello",
+		CustomType:  "greeting",
+		Data:        "extra data",
+	}
+
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		CheckForAuthContentType(t, r)
+		CheckForApiTokenHeader(t, r)
+
+		body := MessageSendRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		if !reflect.DeepEqual(body, sendRequest) {
+			t.Errorf("Message.Send API call received %+v, expected %+v", body, sendRequest)
+		}
+
+		fmt.Fprint(w, messageResponse)
+	})
+
+	message, _, err := client.Messages.Send(ChannelTypeGroup, "sendbird_group_channel_1", &sendRequest)
+	if err != nil {
+		t.Errorf("Message.Send returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(message, expectedMessage) {
+		t.Errorf("Message.Send returned %+v, expected %+v", message, expectedMessage)
+	}
+}
+
+func TestMessageSendAdmin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/open_channels/lobby/messages", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		expected := map[string]interface{}{"message_type": "ADMM", "message": "Maintenance at noon"}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("Message.Send API call received %+v, expected %+v", body, expected)
+		}
+
+		fmt.Fprint(w, `{"message_id": 1, "type": "ADMM", "channel_type": "open", "message": "Maintenance at noon"}`)
+	})
+
+	message, _, err := client.Messages.Send(ChannelTypeOpen, "lobby", &MessageSendRequest{MessageType: MessageTypeAdmin, Message: "Maintenance at noon"})
+	if err != nil {
+		t.Fatalf("Message.Send returned error: %v", err)
+	}
+	if message.Type != MessageTypeAdmin || message.User != nil {
+		t.Errorf("Message.Send returned %+v, expected an admin message without sender", message)
+	}
+}
+
+func TestMessageInvalidChannelType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := client.Messages.Get("messaging", "sendbird_group_channel_1", 1); err == nil {
+		t.Errorf("Message.Get accepted an invalid channel type")
+	}
+}
+
+func TestMessageInvalidParams(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1/messages", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s reached the messages collection", r.Method)
+	})
+
+	if _, _, err := client.Messages.Get(ChannelTypeGroup, "sendbird_group_channel_1", 0); err == nil {
+		t.Errorf("Message.Get accepted message id 0")
+	}
+	if _, _, err := client.Messages.Update(ChannelTypeGroup, "sendbird_group_channel_1", 0, &MessageUpdateRequest{Message: "Hello"}); err == nil {
+		t.Errorf("Message.Update accepted message id 0")
+	}
+	if _, err := client.Messages.Delete(ChannelTypeGroup, "sendbird_group_channel_1", -1); err == nil {
+		t.Errorf("Message.Delete accepted message id -1")
+	}
+	if _, _, err := client.Messages.Update(ChannelTypeGroup, "sendbird_group_channel_1", 1, nil); err == nil {
+		t.Errorf("Message.Update accepted nil params")
+	}
+}
+
+func TestMessageList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/group_channels/sendbird_group_channel_1/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+
+		expected := "custom_types=greeting&include=true&message_id=7451268542&message_type=MESG&prev_limit=20&reverse=true&sender_id=john"
+		if r.URL.RawQuery != expected {
+			t.Errorf("Message.List query = %q, expected %q", r.URL.RawQuery, expected)
+		}
+
+		fmt.Fprintf(w, `{"messages": [%s]}`, messageResponse)
+	})
+
+	messages, _, err := client.Messages.List(ChannelTypeGroup, "sendbird_group_channel_1", &MessageListOptions{
+		MessageId:   7451268542,
+		PrevLimit:   20,
+		Include:     true,
+		Reverse:     true,
+		SenderId:    "john",
+		MessageType: MessageTypeUser,
+		CustomTypes: []string{"greeting"},
+	})
+	if err != nil {
+		t.Errorf("Message.List returned error: %v", err)
+	}
+
+	if expected := []Message{*expectedMessage}; !reflect.DeepEqual(messages, expected) {
+		t.Errorf("Message.List returned %+v, expected %+v", messages, expected)
+	}
+}
+
+func TestMessageListOptionsPoint(t *testing.T) {
+	now := time.UnixMilli(1542123999123)
+
+	for _, tt := range []struct {
+		opts     *MessageListOptions
+		expected string
+	}{
+		{nil, "message_ts=1542123999123"},
+		{&MessageListOptions{NextLimit: 5}, "message_ts=1542123999123&next_limit=5"},
+		{&MessageListOptions{MessageTs: time.UnixMilli(1500000000000)}, "message_ts=1500000000000"},
+		{&MessageListOptions{MessageId: 42}, "message_id=42"},
+	} {
+		if query := tt.opts.values(now).Encode(); query != tt.expected {
+			t.Errorf("values(%+v) = %q, expected %q", tt.opts, query, tt.expected)
+		}
+	}
+}
+
+func TestMessageGetUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/v3/open_channels/lobby/messages/7451268542", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		calls = append(calls, r.Method)
+
+		switch r.Method {
+		case "PUT":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			expected := map[string]interface{}{"message_type": "MESG", "message": "Hello again", "data": "edited"}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("Message.Update API call received %+v, expected %+v", body, expected)
+			}
+		case "DELETE":
+			fmt.Fprint(w, "{}")
+			return
+		}
+		fmt.Fprint(w, messageResponse)
+	})
+
+	message, _, err := client.Messages.Get(ChannelTypeOpen, "lobby", 7451268542)
+	if err != nil {
+		t.Errorf("Message.Get returned error: %v", err)
+	}
+	if !reflect.DeepEqual(message, expectedMessage) {
+		t.Errorf("Message.Get returned %+v, expected %+v", message, expectedMessage)
+	}
+
+	update := &MessageUpdateRequest{Message: "Hello again", Data: "edited"}
+	if _, _, err := client.Messages.Update(ChannelTypeOpen, "lobby", 7451268542, update); err != nil {
+		t.Errorf("Message.Update returned error: %v", err)
+	}
+	if update.MessageType != "" {
+		t.Errorf("Message.Update modified its params")
+	}
+
+	if _, err := client.Messages.Delete(ChannelTypeOpen, "lobby", 7451268542); err != nil {
+		t.Errorf("Message.Delete returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(calls, []string{"GET", "PUT", "DELETE"}) {
+		t.Errorf("calls = %v, expected get, update then delete", calls)
+	}
+}
@@ -1,5 +1,7 @@
 package sendbird
 
+import "context"
+
 type MessagingChannelRequest struct {
 	RequestDefaults
 	Name     string `json:"name,omitempty"`      // (Optional) Topic
@@ -81,19 +83,33 @@ type MessagingChannelView struct {
 // endpoints of the Sendbird API
 type MessagingChannelService interface {
 	Create(*MessagingChannelRequest) (*MessagingChannel, *Response, error)
+	CreateWithContext(ctx context.Context, params *MessagingChannelRequest) (*MessagingChannel, *Response, error)
 	Update(params *MessagingChannelUpdateRequest) (*MessagingChannel, *Response, error)
+	UpdateWithContext(ctx context.Context, params *MessagingChannelUpdateRequest) (*MessagingChannel, *Response, error)
 	Delete(channelUrl string) (*MessagingChannelUrl, *Response, error)
+	DeleteWithContext(ctx context.Context, channelUrl string) (*MessagingChannelUrl, *Response, error)
 	Invite(params *MessagingChannelInviteRequest) (*MessagingChannelUrl, *Response, error)
+	InviteWithContext(ctx context.Context, params *MessagingChannelInviteRequest) (*MessagingChannelUrl, *Response, error)
 	Hide(params *MessagingChannelHideRequest) (*MessagingChannelUrl, *Response, error)
+	HideWithContext(ctx context.Context, params *MessagingChannelHideRequest) (*MessagingChannelUrl, *Response, error)
 	Leave(params *MessagingChannelLeaveRequest) (*MessagingChannelUrl, *Response, error)
+	LeaveWithContext(ctx context.Context, params *MessagingChannelLeaveRequest) (*MessagingChannelUrl, *Response, error)
 	View(channelUrl string) (*MessagingChannelView, *Response, error)
+	ViewWithContext(ctx context.Context, channelUrl string) (*MessagingChannelView, *Response, error)
 	GetMetadata(params *MessagingChannelMetadataRequest) (map[string]string, *Response, error)
+	GetMetadataWithContext(ctx context.Context, params *MessagingChannelMetadataRequest) (map[string]string, *Response, error)
 	SetMetadata(params *MessagingChannelSetMetadataRequest) (map[string]string, *Response, error)
+	SetMetadataWithContext(ctx context.Context, params *MessagingChannelSetMetadataRequest) (map[string]string, *Response, error)
 	GetMetacounter(params *MessagingChannelMetacounterRequest) (map[string]int, *Response, error)
+	GetMetacounterWithContext(ctx context.Context, params *MessagingChannelMetacounterRequest) (map[string]int, *Response, error)
 	SetMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	SetMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	IncreaseMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	IncreaseMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	DecreaseMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
+	DecreaseMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error)
 	MessageCount(channelUrl string) (*MessageCount, *Response, error)
+	MessageCountWithContext(ctx context.Context, channelUrl string) (*MessageCount, *Response, error)
 }
 
 // MessagingChannelServiceOp handles communication with the Messaging Channel related methods of
@@ -106,10 +122,19 @@ var _ MessagingChannelService = &MessagingChannelServiceOp{}
 
 // Create creates a group messaging channel using given name
 func (s *MessagingChannelServiceOp) Create(params *MessagingChannelRequest) (*MessagingChannel, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) CreateWithContext(ctx context.Context, params *MessagingChannelRequest) (*MessagingChannel, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Create")
 
 	path := "/messaging/create"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messagingChannel := new(MessagingChannelResponse)
 	resp, err := s.client.Do(req, messagingChannel)
@@ -123,10 +148,19 @@ func (s *MessagingChannelServiceOp) Create(params *MessagingChannelRequest) (*Me
 
 // Update updates a group messaging channel using given channel_url
 func (s *MessagingChannelServiceOp) Update(params *MessagingChannelUpdateRequest) (*MessagingChannel, *Response, error) {
+	return s.UpdateWithContext(context.Background(), params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) UpdateWithContext(ctx context.Context, params *MessagingChannelUpdateRequest) (*MessagingChannel, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Update")
 
 	path := "/messaging/update"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messagingChannel := new(MessagingChannelResponse)
 	resp, err := s.client.Do(req, messagingChannel)
@@ -140,12 +174,21 @@ func (s *MessagingChannelServiceOp) Update(params *MessagingChannelUpdateRequest
 
 // Delete deletes a group messaging channel using given channel_url
 func (s *MessagingChannelServiceOp) Delete(channelUrl string) (*MessagingChannelUrl, *Response, error) {
+	return s.DeleteWithContext(context.Background(), channelUrl)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) DeleteWithContext(ctx context.Context, channelUrl string) (*MessagingChannelUrl, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Delete")
 
 	path := "/messaging/delete"
 
 	params := MessagingChannelUpdateRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	deleted := new(MessagingChannelUrl)
 	resp, err := s.client.Do(req, deleted)
@@ -159,11 +202,20 @@ func (s *MessagingChannelServiceOp) Delete(channelUrl string) (*MessagingChannel
 
 // Invite invites users to the channel
 func (s *MessagingChannelServiceOp) Invite(params *MessagingChannelInviteRequest) (*MessagingChannelUrl, *Response, error) {
+	return s.InviteWithContext(context.Background(), params)
+}
+
+// InviteWithContext is like Invite but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) InviteWithContext(ctx context.Context, params *MessagingChannelInviteRequest) (*MessagingChannelUrl, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Invite")
 
 	path := "/messaging/invite"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messagingChannelUrl := new(MessagingChannelUrl)
 	resp, err := s.client.Do(req, messagingChannelUrl)
@@ -177,11 +229,20 @@ func (s *MessagingChannelServiceOp) Invite(params *MessagingChannelInviteRequest
 
 // Hide hides a messaging channel from the user messaging channel list
 func (s *MessagingChannelServiceOp) Hide(params *MessagingChannelHideRequest) (*MessagingChannelUrl, *Response, error) {
+	return s.HideWithContext(context.Background(), params)
+}
+
+// HideWithContext is like Hide but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) HideWithContext(ctx context.Context, params *MessagingChannelHideRequest) (*MessagingChannelUrl, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Hide")
 
 	path := "/messaging/hide"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messagingChannelUrl := new(MessagingChannelUrl)
 	resp, err := s.client.Do(req, messagingChannelUrl)
@@ -195,11 +256,20 @@ func (s *MessagingChannelServiceOp) Hide(params *MessagingChannelHideRequest) (*
 
 // Leave leaves a messaging channel
 func (s *MessagingChannelServiceOp) Leave(params *MessagingChannelLeaveRequest) (*MessagingChannelUrl, *Response, error) {
+	return s.LeaveWithContext(context.Background(), params)
+}
+
+// LeaveWithContext is like Leave but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) LeaveWithContext(ctx context.Context, params *MessagingChannelLeaveRequest) (*MessagingChannelUrl, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.Leave")
 
 	path := "/messaging/leave"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	messagingChannelUrl := new(MessagingChannelUrl)
 	resp, err := s.client.Do(req, messagingChannelUrl)
@@ -214,12 +284,21 @@ func (s *MessagingChannelServiceOp) Leave(params *MessagingChannelLeaveRequest)
 
 // View views information of the channel
 func (s *MessagingChannelServiceOp) View(channelUrl string) (*MessagingChannelView, *Response, error) {
+	return s.ViewWithContext(context.Background(), channelUrl)
+}
+
+// ViewWithContext is like View but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) ViewWithContext(ctx context.Context, channelUrl string) (*MessagingChannelView, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.View")
 
 	path := "/messaging/view"
 
 	params := MessagingChannelUpdateRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	view := new(MessagingChannelView)
 	resp, err := s.client.Do(req, view)
@@ -233,11 +312,20 @@ func (s *MessagingChannelServiceOp) View(channelUrl string) (*MessagingChannelVi
 
 // GetMetadata gets values of metadata keys
 func (s *MessagingChannelServiceOp) GetMetadata(params *MessagingChannelMetadataRequest) (map[string]string, *Response, error) {
+	return s.GetMetadataWithContext(context.Background(), params)
+}
+
+// GetMetadataWithContext is like GetMetadata but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) GetMetadataWithContext(ctx context.Context, params *MessagingChannelMetadataRequest) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.GetMetadata")
 
 	path := "/messaging/get_metadata"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metadata := map[string]string{}
 	resp, err := s.client.Do(req, &metadata)
@@ -251,11 +339,20 @@ func (s *MessagingChannelServiceOp) GetMetadata(params *MessagingChannelMetadata
 
 // SetMetadata sets values of metadata keys
 func (s *MessagingChannelServiceOp) SetMetadata(params *MessagingChannelSetMetadataRequest) (map[string]string, *Response, error) {
+	return s.SetMetadataWithContext(context.Background(), params)
+}
+
+// SetMetadataWithContext is like SetMetadata but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) SetMetadataWithContext(ctx context.Context, params *MessagingChannelSetMetadataRequest) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.SetMetadata")
 
 	path := "/messaging/set_metadata"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metadata := map[string]string{}
 	resp, err := s.client.Do(req, &metadata)
@@ -269,11 +366,20 @@ func (s *MessagingChannelServiceOp) SetMetadata(params *MessagingChannelSetMetad
 
 // GetMetacounter gets values of metacounter keys
 func (s *MessagingChannelServiceOp) GetMetacounter(params *MessagingChannelMetacounterRequest) (map[string]int, *Response, error) {
+	return s.GetMetacounterWithContext(context.Background(), params)
+}
+
+// GetMetacounterWithContext is like GetMetacounter but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) GetMetacounterWithContext(ctx context.Context, params *MessagingChannelMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.GetMetacounter")
 
 	path := "/messaging/get_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounter := map[string]int{}
 	resp, err := s.client.Do(req, &metacounter)
@@ -287,11 +393,20 @@ func (s *MessagingChannelServiceOp) GetMetacounter(params *MessagingChannelMetac
 
 // SetMetacounter sets values of metacounter keys. All values should be integer
 func (s *MessagingChannelServiceOp) SetMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.SetMetacounterWithContext(context.Background(), params)
+}
+
+// SetMetacounterWithContext is like SetMetacounter but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) SetMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.SetMetacounter")
 
 	path := "/messaging/set_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounter := map[string]int{}
 	resp, err := s.client.Do(req, &metacounter)
@@ -305,11 +420,20 @@ func (s *MessagingChannelServiceOp) SetMetacounter(params *MessagingChannelSetMe
 
 // IncreaseMetacounter increases values of metacounter keys. All deltas should be integer
 func (s *MessagingChannelServiceOp) IncreaseMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.IncreaseMetacounterWithContext(context.Background(), params)
+}
+
+// IncreaseMetacounterWithContext is like IncreaseMetacounter but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) IncreaseMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.IncreaseMetacounter")
 
 	path := "/messaging/incr_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounter := map[string]int{}
 	resp, err := s.client.Do(req, &metacounter)
@@ -323,11 +447,20 @@ func (s *MessagingChannelServiceOp) IncreaseMetacounter(params *MessagingChannel
 
 // DecreaseMetacounter decreases values of metacounter keys. All deltas should be integer
 func (s *MessagingChannelServiceOp) DecreaseMetacounter(params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	return s.DecreaseMetacounterWithContext(context.Background(), params)
+}
+
+// DecreaseMetacounterWithContext is like DecreaseMetacounter but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) DecreaseMetacounterWithContext(ctx context.Context, params *MessagingChannelSetMetacounterRequest) (map[string]int, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.DecreaseMetacounter")
 
 	path := "/messaging/decr_metacounter"
 
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	metacounter := map[string]int{}
 	resp, err := s.client.Do(req, &metacounter)
@@ -341,12 +474,21 @@ func (s *MessagingChannelServiceOp) DecreaseMetacounter(params *MessagingChannel
 
 // MessageCount gets message count of the channel
 func (s *MessagingChannelServiceOp) MessageCount(channelUrl string) (*MessageCount, *Response, error) {
+	return s.MessageCountWithContext(context.Background(), channelUrl)
+}
+
+// MessageCountWithContext is like MessageCount but carries ctx through to the underlying HTTP request.
+func (s *MessagingChannelServiceOp) MessageCountWithContext(ctx context.Context, channelUrl string) (*MessageCount, *Response, error) {
+	ctx = withOperation(ctx, "MessagingChannel.MessageCount")
 
 	path := "/messaging/message_count"
 
 	params := MessagingChannelUpdateRequest{ChannelUrl: channelUrl}
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	count := new(MessageCount)
 	resp, err := s.client.Do(req, count)
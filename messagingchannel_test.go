@@ -28,7 +28,7 @@ func TestMessagingChannelCreate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -90,7 +90,7 @@ func TestMessagingChannelUpdate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -145,7 +145,7 @@ func TestMessagingChannelDelete(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -196,7 +196,7 @@ func TestMessagingChannelInvite(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -246,7 +246,7 @@ func TestMessagingChannelHide(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -296,7 +296,7 @@ func TestMessagingChannelLeave(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -341,7 +341,7 @@ func TestMessagingChannelView(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -421,7 +421,7 @@ func TestMessagingChannelGetMetadata(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -472,7 +472,7 @@ func TestMessagingChannelSetMetadata(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -523,7 +523,7 @@ func TestMessagingChannelGetMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -574,7 +574,7 @@ func TestMessagingChannelSetMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -625,7 +625,7 @@ func TestMessagingChannelIncreaseMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -676,7 +676,7 @@ func TestMessagingChannelDecreaseMetacounter(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -722,7 +722,7 @@ func TestMessagingChannelMessageCount(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
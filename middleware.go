@@ -0,0 +1,97 @@
+package sendbird
+
+import (
+	"context"
+	"net/http"
+)
+
+// RoundTripFunc sends an API request and returns its raw response, like http.RoundTripper.RoundTrip.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the round trip made by Do. It may inspect or modify the request before calling next, inspect
+// or replace the response afterwards, or return a response of its own without calling next at all.
+//
+// Middleware run once per call to Do, outside of the retry loop and the rate limiter, in the order they were
+// installed: the first one installed is the outermost.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+type operationKey struct{}
+
+// withOperation records the service method issuing a call in ctx.
+func withOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// OperationName returns the service method that issued a request, e.g. "ChatChannel.Send", given the request's
+// context. It returns "" for requests built directly with NewRequest. Middleware use it to label calls.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// Use appends middleware to the chain run by Do.
+func (c *SendbirdClient) Use(mw ...Middleware) {
+	c.middleware = append(c.middleware, mw...)
+}
+
+// WithMiddleware installs middleware on the client, see Use.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *SendbirdClient) error {
+		c.Use(mw...)
+		return nil
+	}
+}
+
+// BeforeSend returns a middleware calling fn before the request is sent. If fn returns an error the request is
+// not sent and Do returns that error.
+func BeforeSend(fn func(req *http.Request) error) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// AfterReceive returns a middleware calling fn once a response was received. If fn returns an error the
+// response is discarded and Do returns that error.
+func AfterReceive(fn func(req *http.Request, resp *http.Response) error) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return resp, err
+			}
+			if err := fn(req, resp); err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			return resp, nil
+		}
+	}
+}
+
+// roundTrip sends req through the middleware chain, ending with send.
+func (c *SendbirdClient) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+
+	resp, err := next(req)
+	if resp != nil {
+		// short-circuiting middleware may hand back bare responses
+		if resp.Body == nil {
+			resp.Body = http.NoBody
+		}
+		if resp.Request == nil {
+			resp.Request = req
+		}
+		if resp.Header == nil {
+			resp.Header = http.Header{}
+		}
+	}
+	return resp, err
+}
@@ -0,0 +1,146 @@
+package sendbird
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, "before "+name)
+				resp, err := next(req)
+				order = append(order, "after "+name)
+				return resp, err
+			}
+		}
+	}
+	client.Use(trace("outer"), trace("inner"))
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, "server")
+		fmt.Fprint(w, `{"count": 3}`)
+	})
+
+	if _, _, err := client.Admin.ConcurrentUserCount(); err != nil {
+		t.Fatalf("Admin.ConcurrentUserCount returned error: %v", err)
+	}
+
+	expected := []string{"before outer", "before inner", "server", "after inner", "after outer"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("middleware ran in order %v, expected %v", order, expected)
+	}
+}
+
+func TestMiddlewareMutatesRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Use(BeforeSend(func(req *http.Request) error {
+		req.Header.Set("X-Signature", "signed")
+		return nil
+	}))
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "signed" {
+			t.Errorf("request is missing the header set by middleware")
+		}
+		fmt.Fprint(w, `{"count": 3}`)
+	})
+
+	if _, _, err := client.Admin.ConcurrentUserCount(); err != nil {
+		t.Fatalf("Admin.ConcurrentUserCount returned error: %v", err)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request should not reach the server")
+	})
+
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"count": 7}`)),
+			}, nil
+		}
+	})
+
+	count, _, err := client.Admin.ConcurrentUserCount()
+	if err != nil {
+		t.Fatalf("Admin.ConcurrentUserCount returned error: %v", err)
+	}
+
+	if count.Count != 7 {
+		t.Errorf("Admin.ConcurrentUserCount returned %+v, expected the cached count", count)
+	}
+}
+
+func TestMiddlewareErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"count": 3}`)
+	})
+
+	denied := errors.New("denied")
+	c, _ := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN", WithBaseURL(server.URL),
+		WithMiddleware(BeforeSend(func(req *http.Request) error { return denied })))
+
+	if _, _, err := c.Admin.ConcurrentUserCount(); err != denied {
+		t.Errorf("BeforeSend error = %v, expected %v", err, denied)
+	}
+
+	audit := errors.New("audit failed")
+	client.Use(AfterReceive(func(req *http.Request, resp *http.Response) error { return audit }))
+
+	if _, _, err := client.Admin.ConcurrentUserCount(); err != audit {
+		t.Errorf("AfterReceive error = %v, expected %v", err, audit)
+	}
+
+	if calls != 1 {
+		t.Errorf("server received %d calls, expected 1", calls)
+	}
+}
+
+func TestMiddlewareOperationName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var names []string
+	client.Use(BeforeSend(func(req *http.Request) error {
+		names = append(names, OperationName(req.Context()))
+		return nil
+	}))
+
+	mux.HandleFunc("/channel/send", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	client.Chat.Send(&ChatChannelMessageRequest{Id: "1", ChannelUrl: "url", Message: "hi"})
+
+	req, _ := client.NewRequest("POST", "/channel/send", nil)
+	client.Do(req, nil)
+
+	expected := []string{"ChatChannel.Send", ""}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("OperationName returned %q, expected %q", names, expected)
+	}
+}
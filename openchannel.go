@@ -0,0 +1,474 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// OpenChannel is an open channel as returned by the Platform API v3.
+type OpenChannel struct {
+	ChannelUrl       string       `json:"channel_url"`
+	Name             string       `json:"name"`
+	CoverUrl         string       `json:"cover_url"`
+	CustomType       string       `json:"custom_type"`
+	Data             string       `json:"data"`
+	IsEphemeral      bool         `json:"is_ephemeral"`
+	Freeze           bool         `json:"freeze"`
+	ParticipantCount int          `json:"participant_count"`
+	MaxLengthMessage int          `json:"max_length_message"`
+	Operators        []UserV3     `json:"operators,omitempty"`
+	CreatedAt        EpochSeconds `json:"created_at"`
+}
+
+// OpenChannelList is a page of open channels. Next is the token of the following page, empty on the last one.
+type OpenChannelList struct {
+	Channels []OpenChannel `json:"channels"`
+	Next     string        `json:"next"`
+}
+
+// OpenChannelUserList is a page of the participants or operators of an open channel.
+type OpenChannelUserList struct {
+	Users []UserV3 `json:"users"`
+	Next  string   `json:"next"`
+}
+
+type OpenChannelCreateRequest struct {
+	Name        string   `json:"name,omitempty"`
+	ChannelUrl  string   `json:"channel_url,omitempty"` // generated when empty
+	CoverUrl    string   `json:"cover_url,omitempty"`
+	CustomType  string   `json:"custom_type,omitempty"`
+	Data        string   `json:"data,omitempty"`
+	IsEphemeral bool     `json:"is_ephemeral,omitempty"` // messages aren't stored
+	OperatorIds []string `json:"operator_ids,omitempty"`
+}
+
+// OpenChannelUpdateRequest updates an open channel. Empty fields are left unchanged.
+type OpenChannelUpdateRequest struct {
+	Name        string   `json:"name,omitempty"`
+	CoverUrl    string   `json:"cover_url,omitempty"`
+	CustomType  string   `json:"custom_type,omitempty"`
+	Data        string   `json:"data,omitempty"`
+	OperatorIds []string `json:"operator_ids,omitempty"` // replaces the operators
+}
+
+// OpenChannelListOptions filters and paginates OpenChannelService.List. Zero fields are not sent.
+type OpenChannelListOptions struct {
+	Token        string   // page token, OpenChannelList.Next of the previous page
+	Limit        int      // channels per page, 1 to 100
+	NameContains string   // channels whose name contains this
+	UrlContains  string   // channels whose URL contains this
+	CustomTypes  []string // channels with one of these custom types
+	ShowFrozen   *bool    // whether to include frozen channels, true by default
+}
+
+// values encodes the options as query parameters.
+func (o *OpenChannelListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Token != "" {
+		v.Set("token", o.Token)
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.NameContains != "" {
+		v.Set("name_contains", o.NameContains)
+	}
+	if o.UrlContains != "" {
+		v.Set("url_contains", o.UrlContains)
+	}
+	if len(o.CustomTypes) > 0 {
+		v.Set("custom_types", strings.Join(o.CustomTypes, ","))
+	}
+	if o.ShowFrozen != nil {
+		v.Set("show_frozen", strconv.FormatBool(*o.ShowFrozen))
+	}
+	return v
+}
+
+// pageQuery returns the query of a paginated list, starting with "?", or "".
+func pageQuery(token string, limit int) string {
+	v := url.Values{}
+	if token != "" {
+		v.Set("token", token)
+	}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// OpenChannelService is an interface for interfacing with the /v3/open_channels
+// endpoints of the Sendbird Platform API
+type OpenChannelService interface {
+	Create(params *OpenChannelCreateRequest) (*OpenChannel, *Response, error)
+	CreateWithContext(ctx context.Context, params *OpenChannelCreateRequest) (*OpenChannel, *Response, error)
+	List(opts *OpenChannelListOptions) (*OpenChannelList, *Response, error)
+	ListWithContext(ctx context.Context, opts *OpenChannelListOptions) (*OpenChannelList, *Response, error)
+	Get(channelUrl string) (*OpenChannel, *Response, error)
+	GetWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error)
+	Update(channelUrl string, params *OpenChannelUpdateRequest) (*OpenChannel, *Response, error)
+	UpdateWithContext(ctx context.Context, channelUrl string, params *OpenChannelUpdateRequest) (*OpenChannel, *Response, error)
+	Delete(channelUrl string) (*Response, error)
+	DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error)
+	Participants(channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error)
+	ParticipantsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error)
+	Operators(channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error)
+	OperatorsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error)
+	AddOperators(channelUrl string, userIds []string) (*Response, error)
+	AddOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error)
+	RemoveOperators(channelUrl string, userIds []string) (*Response, error)
+	RemoveOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error)
+	Freeze(channelUrl string) (*OpenChannel, *Response, error)
+	FreezeWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error)
+	Unfreeze(channelUrl string) (*OpenChannel, *Response, error)
+	UnfreezeWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error)
+	Metadata(channelUrl string) (map[string]string, *Response, error)
+	MetadataWithContext(ctx context.Context, channelUrl string) (map[string]string, *Response, error)
+	CreateMetadata(channelUrl string, metadata map[string]string) (map[string]string, *Response, error)
+	CreateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string) (map[string]string, *Response, error)
+	UpdateMetadata(channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *Response, error)
+	UpdateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *Response, error)
+	DeleteMetadata(channelUrl string, key string) (*Response, error)
+	DeleteMetadataWithContext(ctx context.Context, channelUrl string, key string) (*Response, error)
+	DeleteAllMetadata(channelUrl string) (*Response, error)
+	DeleteAllMetadataWithContext(ctx context.Context, channelUrl string) (*Response, error)
+}
+
+// OpenChannelServiceOp handles communication with the /v3/open_channels
+// related methods of the Sendbird Platform API.
+type OpenChannelServiceOp struct {
+	client *SendbirdClient
+}
+
+var _ OpenChannelService = &OpenChannelServiceOp{}
+
+// openChannelPath returns the path of an open channel, followed by elems. An empty channel url would address the
+// collection instead, so it is refused.
+func openChannelPath(channelUrl string, elems ...string) (string, error) {
+	if channelUrl == "" {
+		return "", fmt.Errorf("sendbird: channel url is empty")
+	}
+	return v3Path("open_channels", append([]string{channelUrl}, elems...)...), nil
+}
+
+// Create creates an open channel
+func (s *OpenChannelServiceOp) Create(params *OpenChannelCreateRequest) (*OpenChannel, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) CreateWithContext(ctx context.Context, params *OpenChannelCreateRequest) (*OpenChannel, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Create")
+
+	return s.callChannel(ctx, "POST", v3Path("open_channels"), params)
+}
+
+// List returns a page of open channels matching opts, which may be nil
+func (s *OpenChannelServiceOp) List(opts *OpenChannelListOptions) (*OpenChannelList, *Response, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) ListWithContext(ctx context.Context, opts *OpenChannelListOptions) (*OpenChannelList, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.List")
+
+	path := v3Path("open_channels")
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	list := new(OpenChannelList)
+	resp, err := s.client.call(ctx, "GET", path, nil, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// Get retrieves an open channel
+func (s *OpenChannelServiceOp) Get(channelUrl string) (*OpenChannel, *Response, error) {
+	return s.GetWithContext(context.Background(), channelUrl)
+}
+
+// GetWithContext is like Get but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) GetWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Get")
+
+	path, err := openChannelPath(channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.callChannel(ctx, "GET", path, nil)
+}
+
+// Update updates an open channel
+func (s *OpenChannelServiceOp) Update(channelUrl string, params *OpenChannelUpdateRequest) (*OpenChannel, *Response, error) {
+	return s.UpdateWithContext(context.Background(), channelUrl, params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) UpdateWithContext(ctx context.Context, channelUrl string, params *OpenChannelUpdateRequest) (*OpenChannel, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Update")
+
+	path, err := openChannelPath(channelUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.callChannel(ctx, "PUT", path, params)
+}
+
+// Delete deletes an open channel
+func (s *OpenChannelServiceOp) Delete(channelUrl string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), channelUrl)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) DeleteWithContext(ctx context.Context, channelUrl string) (*Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Delete")
+
+	path, err := openChannelPath(channelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// Participants returns a page of the users currently in an open channel. token is the Next of the previous page,
+// "" for the first one, and limit is the page size, or 0 for the default.
+func (s *OpenChannelServiceOp) Participants(channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error) {
+	return s.ParticipantsWithContext(context.Background(), channelUrl, token, limit)
+}
+
+// ParticipantsWithContext is like Participants but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) ParticipantsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Participants")
+
+	path, err := openChannelPath(channelUrl, "participants")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := struct {
+		Participants []UserV3 `json:"participants"`
+		Next         string   `json:"next"`
+	}{}
+	resp, err := s.client.call(ctx, "GET", path+pageQuery(token, limit), nil, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &OpenChannelUserList{Users: page.Participants, Next: page.Next}, resp, nil
+}
+
+// Operators returns a page of the operators of an open channel, see Participants for token and limit
+func (s *OpenChannelServiceOp) Operators(channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error) {
+	return s.OperatorsWithContext(context.Background(), channelUrl, token, limit)
+}
+
+// OperatorsWithContext is like Operators but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) OperatorsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*OpenChannelUserList, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Operators")
+
+	path, err := openChannelPath(channelUrl, "operators")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := struct {
+		Operators []UserV3 `json:"operators"`
+		Next      string   `json:"next"`
+	}{}
+	resp, err := s.client.call(ctx, "GET", path+pageQuery(token, limit), nil, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &OpenChannelUserList{Users: page.Operators, Next: page.Next}, resp, nil
+}
+
+// AddOperators registers users as operators of an open channel
+func (s *OpenChannelServiceOp) AddOperators(channelUrl string, userIds []string) (*Response, error) {
+	return s.AddOperatorsWithContext(context.Background(), channelUrl, userIds)
+}
+
+// AddOperatorsWithContext is like AddOperators but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) AddOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.AddOperators")
+
+	path, err := openChannelPath(channelUrl, "operators")
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		OperatorIds []string `json:"operator_ids"`
+	}{userIds}
+	return s.client.call(ctx, "POST", path, params, nil)
+}
+
+// RemoveOperators unregisters operators of an open channel
+func (s *OpenChannelServiceOp) RemoveOperators(channelUrl string, userIds []string) (*Response, error) {
+	return s.RemoveOperatorsWithContext(context.Background(), channelUrl, userIds)
+}
+
+// RemoveOperatorsWithContext is like RemoveOperators but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) RemoveOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.RemoveOperators")
+
+	path, err := openChannelPath(channelUrl, "operators")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(userIds) == 0 {
+		return nil, fmt.Errorf("sendbird: no operators to remove from channel %q", channelUrl)
+	}
+	query := url.Values{"operator_ids": {strings.Join(userIds, ",")}}.Encode()
+	return s.client.call(ctx, "DELETE", path+"?"+query, nil, nil)
+}
+
+// Freeze freezes an open channel: only operators can send messages until it is unfrozen
+func (s *OpenChannelServiceOp) Freeze(channelUrl string) (*OpenChannel, *Response, error) {
+	return s.FreezeWithContext(context.Background(), channelUrl)
+}
+
+// FreezeWithContext is like Freeze but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) FreezeWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Freeze")
+
+	return s.setFrozen(ctx, channelUrl, true)
+}
+
+// Unfreeze unfreezes an open channel
+func (s *OpenChannelServiceOp) Unfreeze(channelUrl string) (*OpenChannel, *Response, error) {
+	return s.UnfreezeWithContext(context.Background(), channelUrl)
+}
+
+// UnfreezeWithContext is like Unfreeze but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) UnfreezeWithContext(ctx context.Context, channelUrl string) (*OpenChannel, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Unfreeze")
+
+	return s.setFrozen(ctx, channelUrl, false)
+}
+
+func (s *OpenChannelServiceOp) setFrozen(ctx context.Context, channelUrl string, freeze bool) (*OpenChannel, *Response, error) {
+	path, err := openChannelPath(channelUrl, "freeze")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := struct {
+		Freeze bool `json:"freeze"`
+	}{freeze}
+	return s.callChannel(ctx, "PUT", path, params)
+}
+
+// Metadata retrieves the metadata of an open channel
+func (s *OpenChannelServiceOp) Metadata(channelUrl string) (map[string]string, *Response, error) {
+	return s.MetadataWithContext(context.Background(), channelUrl)
+}
+
+// MetadataWithContext is like Metadata but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) MetadataWithContext(ctx context.Context, channelUrl string) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.Metadata")
+
+	path, err := openChannelPath(channelUrl, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.callMetadata(ctx, "GET", path, nil)
+}
+
+// CreateMetadata adds metadata to an open channel. It fails when a key already exists, see UpdateMetadata.
+func (s *OpenChannelServiceOp) CreateMetadata(channelUrl string, metadata map[string]string) (map[string]string, *Response, error) {
+	return s.CreateMetadataWithContext(context.Background(), channelUrl, metadata)
+}
+
+// CreateMetadataWithContext is like CreateMetadata but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) CreateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.CreateMetadata")
+
+	path, err := openChannelPath(channelUrl, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := metadataRequest{Metadata: metadata}
+	return s.client.callMetadata(ctx, "POST", path, params)
+}
+
+// UpdateMetadata updates existing metadata keys of an open channel, adding missing ones when upsert is set
+func (s *OpenChannelServiceOp) UpdateMetadata(channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *Response, error) {
+	return s.UpdateMetadataWithContext(context.Background(), channelUrl, metadata, upsert)
+}
+
+// UpdateMetadataWithContext is like UpdateMetadata but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) UpdateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.UpdateMetadata")
+
+	path, err := openChannelPath(channelUrl, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := metadataRequest{Metadata: metadata, Upsert: upsert}
+	return s.client.callMetadata(ctx, "PUT", path, params)
+}
+
+// DeleteMetadata deletes a metadata key of an open channel
+func (s *OpenChannelServiceOp) DeleteMetadata(channelUrl string, key string) (*Response, error) {
+	return s.DeleteMetadataWithContext(context.Background(), channelUrl, key)
+}
+
+// DeleteMetadataWithContext is like DeleteMetadata but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) DeleteMetadataWithContext(ctx context.Context, channelUrl string, key string) (*Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.DeleteMetadata")
+
+	path, err := openChannelPath(channelUrl, "metadata", key)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("sendbird: metadata key of channel %q is empty", channelUrl)
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// DeleteAllMetadata deletes every metadata key of an open channel
+func (s *OpenChannelServiceOp) DeleteAllMetadata(channelUrl string) (*Response, error) {
+	return s.DeleteAllMetadataWithContext(context.Background(), channelUrl)
+}
+
+// DeleteAllMetadataWithContext is like DeleteAllMetadata but carries ctx through to the underlying HTTP request.
+func (s *OpenChannelServiceOp) DeleteAllMetadataWithContext(ctx context.Context, channelUrl string) (*Response, error) {
+	ctx = withOperation(ctx, "OpenChannel.DeleteAllMetadata")
+
+	path, err := openChannelPath(channelUrl, "metadata")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// callChannel is like call for the requests answered with an open channel.
+func (s *OpenChannelServiceOp) callChannel(ctx context.Context, method, path string, body interface{}) (*OpenChannel, *Response, error) {
+	channel := new(OpenChannel)
+	resp, err := s.client.call(ctx, method, path, body, channel)
+	if err != nil {
+		return nil, resp, err
+	}
+	return channel, resp, nil
+}
@@ -0,0 +1,346 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const openChannelResponse = `
+{
+    "channel_url": "sendbird_open_channel_1",
+    "name": "Lobby",
+    "cover_url": "https://sendbird.com/main/img/cover/cover_08.jpg",
+    "custom_type": "lobby",
+    "data": "",
+    "is_ephemeral": false,
+    "freeze": false,
+    "participant_count": 12,
+    "max_length_message": 5000,
+    "created_at": 1542123432,
+    "operators": [{"user_id": "john", "nickname": "John", "profile_url": ""}]
+}`
+
+var expectedOpenChannel = &OpenChannel{
+	ChannelUrl:       "sendbird_open_channel_1",
+	Name:             "Lobby",
+	CoverUrl:         "https://sendbird.com/main/img/cover/cover_08.jpg",
+	CustomType:       "lobby",
+	ParticipantCount: 12,
+	MaxLengthMessage: 5000,
+	CreatedAt:        EpochSeconds{time.Unix(1542123432, 0)},
+	Operators:        []UserV3{{UserId: "john", Nickname: "John"}},
+}
+
+func TestOpenChannelCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := OpenChannelCreateRequest{
+		Name:        "Lobby",
+		CustomType:  "lobby",
+		OperatorIds: []string{"john"},
+	}
+
+	mux.HandleFunc("/v3/open_channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		CheckForAuthContentType(t, r)
+		CheckForApiTokenHeader(t, r)
+
+		body := OpenChannelCreateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		if !reflect.DeepEqual(body, createRequest) {
+			t.Errorf("OpenChannel.Create API call received %+v, expected %+v", body, createRequest)
+		}
+
+		fmt.Fprint(w, openChannelResponse)
+	})
+
+	channel, _, err := client.OpenChannels.Create(&createRequest)
+	if err != nil {
+		t.Errorf("OpenChannel.Create returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(channel, expectedOpenChannel) {
+		t.Errorf("OpenChannel.Create returned %+v, expected %+v", channel, expectedOpenChannel)
+	}
+}
+
+func TestOpenChannelList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/open_channels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+
+		expected := "custom_types=lobby&limit=10&name_contains=Lob&show_frozen=false&token=NEXT_PAGE&url_contains=open"
+		if r.URL.RawQuery != expected {
+			t.Errorf("OpenChannel.List query = %q, expected %q", r.URL.RawQuery, expected)
+		}
+
+		fmt.Fprintf(w, `{"channels": [%s], "next": ""}`, openChannelResponse)
+	})
+
+	showFrozen := false
+	list, _, err := client.OpenChannels.List(&OpenChannelListOptions{
+		Token:        "NEXT_PAGE",
+		Limit:        10,
+		NameContains: "Lob",
+		UrlContains:  "open",
+		CustomTypes:  []string{"lobby"},
+		ShowFrozen:   &showFrozen,
+	})
+	if err != nil {
+		t.Errorf("OpenChannel.List returned error: %v", err)
+	}
+
+	expected := &OpenChannelList{Channels: []OpenChannel{*expectedOpenChannel}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("OpenChannel.List returned %+v, expected %+v", list, expected)
+	}
+}
+
+func TestOpenChannelGetUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		calls = append(calls, r.Method)
+
+		if r.Method == "PUT" {
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			expected := map[string]interface{}{"name": "Main lobby"}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("OpenChannel.Update API call received %+v, expected %+v", body, expected)
+			}
+		}
+		if r.Method == "DELETE" {
+			fmt.Fprint(w, "{}")
+			return
+		}
+		fmt.Fprint(w, openChannelResponse)
+	})
+
+	channel, _, err := client.OpenChannels.Get("sendbird_open_channel_1")
+	if err != nil {
+		t.Errorf("OpenChannel.Get returned error: %v", err)
+	}
+	if !reflect.DeepEqual(channel, expectedOpenChannel) {
+		t.Errorf("OpenChannel.Get returned %+v, expected %+v", channel, expectedOpenChannel)
+	}
+
+	if _, _, err := client.OpenChannels.Update("sendbird_open_channel_1", &OpenChannelUpdateRequest{Name: "Main lobby"}); err != nil {
+		t.Errorf("OpenChannel.Update returned error: %v", err)
+	}
+	if _, err := client.OpenChannels.Delete("sendbird_open_channel_1"); err != nil {
+		t.Errorf("OpenChannel.Delete returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(calls, []string{"GET", "PUT", "DELETE"}) {
+		t.Errorf("calls = %v, expected get, update then delete", calls)
+	}
+}
+
+func TestOpenChannelParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1/participants", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		CheckForApiTokenHeader(t, r)
+
+		if r.URL.RawQuery != "limit=2&token=NEXT_PAGE" {
+			t.Errorf("OpenChannel.Participants query = %q", r.URL.RawQuery)
+		}
+
+		fmt.Fprint(w, `{"participants": [{"user_id": "john", "nickname": "John", "is_online": true}], "next": "LAST_PAGE"}`)
+	})
+
+	list, _, err := client.OpenChannels.Participants("sendbird_open_channel_1", "NEXT_PAGE", 2)
+	if err != nil {
+		t.Errorf("OpenChannel.Participants returned error: %v", err)
+	}
+
+	expected := &OpenChannelUserList{Users: []UserV3{{UserId: "john", Nickname: "John", IsOnline: true}}, Next: "LAST_PAGE"}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("OpenChannel.Participants returned %+v, expected %+v", list, expected)
+	}
+}
+
+func TestOpenChannelOperators(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1/operators", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		calls = append(calls, r.Method)
+
+		switch r.Method {
+		case "GET":
+			if r.URL.RawQuery != "" {
+				t.Errorf("OpenChannel.Operators query = %q, expected none", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"operators": [{"user_id": "john", "nickname": "John"}], "next": ""}`)
+			return
+		case "POST":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			expected := map[string]interface{}{"operator_ids": []interface{}{"jane", "jack"}}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("OpenChannel.AddOperators API call received %+v, expected %+v", body, expected)
+			}
+		case "DELETE":
+			if r.URL.Query().Get("operator_ids") != "jane,jack" {
+				t.Errorf("OpenChannel.RemoveOperators query = %q", r.URL.RawQuery)
+			}
+		}
+		fmt.Fprint(w, "{}")
+	})
+
+	list, _, err := client.OpenChannels.Operators("sendbird_open_channel_1", "", 0)
+	if err != nil {
+		t.Errorf("OpenChannel.Operators returned error: %v", err)
+	}
+	if expected := []UserV3{{UserId: "john", Nickname: "John"}}; !reflect.DeepEqual(list.Users, expected) {
+		t.Errorf("OpenChannel.Operators returned %+v, expected %+v", list.Users, expected)
+	}
+
+	if _, err := client.OpenChannels.AddOperators("sendbird_open_channel_1", []string{"jane", "jack"}); err != nil {
+		t.Errorf("OpenChannel.AddOperators returned error: %v", err)
+	}
+	if _, err := client.OpenChannels.RemoveOperators("sendbird_open_channel_1", []string{"jane", "jack"}); err != nil {
+		t.Errorf("OpenChannel.RemoveOperators returned error: %v", err)
+	}
+	if _, err := client.OpenChannels.RemoveOperators("sendbird_open_channel_1", nil); err == nil {
+		t.Errorf("OpenChannel.RemoveOperators accepted no operators")
+	}
+
+	if !reflect.DeepEqual(calls, []string{"GET", "POST", "DELETE"}) {
+		t.Errorf("calls = %v, expected list, add then remove", calls)
+	}
+}
+
+func TestOpenChannelFreeze(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var frozen []interface{}
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1/freeze", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		CheckForApiTokenHeader(t, r)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		frozen = append(frozen, body["freeze"])
+
+		fmt.Fprintf(w, `{"channel_url": "sendbird_open_channel_1", "freeze": %v}`, body["freeze"])
+	})
+
+	channel, _, err := client.OpenChannels.Freeze("sendbird_open_channel_1")
+	if err != nil || !channel.Freeze {
+		t.Errorf("OpenChannel.Freeze returned %+v, %v, expected a frozen channel", channel, err)
+	}
+	channel, _, err = client.OpenChannels.Unfreeze("sendbird_open_channel_1")
+	if err != nil || channel.Freeze {
+		t.Errorf("OpenChannel.Unfreeze returned %+v, %v, expected a channel that isn't frozen", channel, err)
+	}
+
+	if !reflect.DeepEqual(frozen, []interface{}{true, false}) {
+		t.Errorf("freeze = %v, expected true then false", frozen)
+	}
+}
+
+func TestOpenChannelMetadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	metadata := map[string]string{"topic": "weekend"}
+	var calls []string
+
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1/metadata", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		calls = append(calls, r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		if r.Method == "PUT" && body["upsert"] != true {
+			t.Errorf("OpenChannel.UpdateMetadata API call received %+v, expected upsert", body)
+		}
+		if r.Method == "DELETE" {
+			fmt.Fprint(w, "{}")
+			return
+		}
+		fmt.Fprint(w, `{"topic": "weekend"}`)
+	})
+	mux.HandleFunc("/v3/open_channels/sendbird_open_channel_1/metadata/topic", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "DELETE key")
+		fmt.Fprint(w, "{}")
+	})
+
+	url := "sendbird_open_channel_1"
+	if got, _, err := client.OpenChannels.CreateMetadata(url, metadata); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("OpenChannel.CreateMetadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if got, _, err := client.OpenChannels.UpdateMetadata(url, metadata, true); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("OpenChannel.UpdateMetadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if got, _, err := client.OpenChannels.Metadata(url); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("OpenChannel.Metadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if _, err := client.OpenChannels.DeleteMetadata(url, "topic"); err != nil {
+		t.Errorf("OpenChannel.DeleteMetadata returned error: %v", err)
+	}
+	if _, err := client.OpenChannels.DeleteAllMetadata(url); err != nil {
+		t.Errorf("OpenChannel.DeleteAllMetadata returned error: %v", err)
+	}
+
+	expected := []string{"POST", "PUT", "GET", "DELETE key", "DELETE"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("calls = %v, expected %v", calls, expected)
+	}
+}
+
+func TestOpenChannelEmptyUrl(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/open_channels/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s reached the server", r.Method, r.URL.Path)
+	})
+
+	if _, _, err := client.OpenChannels.Get(""); err == nil {
+		t.Errorf("OpenChannel.Get accepted an empty channel url")
+	}
+	if _, err := client.OpenChannels.Delete(""); err == nil {
+		t.Errorf("OpenChannel.Delete accepted an empty channel url")
+	}
+	if _, _, err := client.OpenChannels.Participants("", "", 10); err == nil {
+		t.Errorf("OpenChannel.Participants accepted an empty channel url")
+	}
+	if _, err := client.OpenChannels.AddOperators("", []string{"john"}); err == nil {
+		t.Errorf("OpenChannel.AddOperators accepted an empty channel url")
+	}
+	if _, _, err := client.OpenChannels.Freeze(""); err == nil {
+		t.Errorf("OpenChannel.Freeze accepted an empty channel url")
+	}
+	if _, err := client.OpenChannels.DeleteAllMetadata(""); err == nil {
+		t.Errorf("OpenChannel.DeleteAllMetadata accepted an empty channel url")
+	}
+}
@@ -0,0 +1,97 @@
+package sendbird
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// ClientOption configures a SendbirdClient created by NewClient.
+type ClientOption func(*SendbirdClient) error
+
+var regionPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// WithHTTPClient sets the HTTP client used to talk to Sendbird. Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *SendbirdClient) error {
+		if httpClient == nil {
+			return errors.New("sendbird: http client must not be nil")
+		}
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL for API requests. It must be an absolute http or https URL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *SendbirdClient) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("sendbird: invalid base url %q: %v", baseURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("sendbird: invalid base url %q: must be an absolute http or https url", baseURL)
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithRegion points the client at the regional Sendbird host https://api-<region>.sendbird.com.
+func WithRegion(region string) ClientOption {
+	return func(c *SendbirdClient) error {
+		if !regionPattern.MatchString(region) {
+			return fmt.Errorf("sendbird: invalid region %q", region)
+		}
+		return WithBaseURL(fmt.Sprintf("https://api-%s.sendbird.com", region))(c)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *SendbirdClient) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout of every HTTP attempt. The HTTP client is copied, not modified.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *SendbirdClient) error {
+		if timeout < 0 {
+			return fmt.Errorf("sendbird: invalid timeout %v", timeout)
+		}
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry transient failures, see SetRetryPolicy.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(c *SendbirdClient) error {
+		if p != nil && (p.MaxAttempts < 0 || p.MinBackoff < 0 || p.MaxBackoff < 0) {
+			return errors.New("sendbird: retry policy values must not be negative")
+		}
+		c.SetRetryPolicy(p)
+		return nil
+	}
+}
+
+// WithRateLimiter installs a client side rate limiter, see SetRateLimiter.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(c *SendbirdClient) error {
+		c.SetRateLimiter(l)
+		return nil
+	}
+}
+
+// WithRequestCompleted sets the callback invoked after every completed HTTP attempt, see OnRequestCompleted.
+func WithRequestCompleted(rc RequestCompletionCallback) ClientOption {
+	return func(c *SendbirdClient) error {
+		c.OnRequestCompleted(rc)
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package sendbird
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	policy := DefaultRetryPolicy()
+	limiter := NewRateLimiter(Limit{Rate: 1, Burst: 1})
+
+	c, err := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN",
+		WithTimeout(5*time.Second),
+		WithHTTPClient(httpClient),
+		WithRegion("us-1"),
+		WithUserAgent("my-app/1.0"),
+		WithRetryPolicy(policy),
+		WithRateLimiter(limiter),
+	)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if c.BaseURL.String() != "https://api-us-1.sendbird.com" {
+		t.Errorf("NewClient BaseURL = %v", c.BaseURL)
+	}
+
+	if c.client == httpClient || c.client.Timeout != 5*time.Second || httpClient.Timeout != 0 {
+		t.Errorf("WithTimeout should apply to a copy of the HTTP client")
+	}
+
+	if c.retryPolicy != policy || c.RateLimiter() != limiter {
+		t.Errorf("NewClient did not install the retry policy and rate limiter")
+	}
+
+	req, _ := c.NewRequest("GET", "/v2/bots", nil)
+	if ua := req.Header.Get("User-Agent"); ua != "my-app/1.0" {
+		t.Errorf("User-Agent = %q, expected %q", ua, "my-app/1.0")
+	}
+}
+
+func TestNewClientDoesNotModifyDefaultClient(t *testing.T) {
+	if _, err := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN", WithTimeout(time.Second)); err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if http.DefaultClient.Timeout != 0 {
+		t.Errorf("WithTimeout modified http.DefaultClient")
+	}
+}
+
+func TestNewClientValidation(t *testing.T) {
+	cases := map[string][]ClientOption{
+		"relative base url": {WithBaseURL("/sendbird")},
+		"ftp base url":      {WithBaseURL("ftp://api.sendbird.com")},
+		"bad base url":      {WithBaseURL("http://[::1")},
+		"bad region":        {WithRegion("us 1/")},
+		"nil http client":   {WithHTTPClient(nil)},
+		"negative timeout":  {WithTimeout(-time.Second)},
+		"negative retries":  {WithRetryPolicy(&RetryPolicy{MaxAttempts: -1})},
+	}
+
+	for name, opts := range cases {
+		if c, err := NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN", opts...); err == nil || c != nil {
+			t.Errorf("%s: NewClient returned %v, %v, expected an error", name, c, err)
+		}
+	}
+
+	if _, err := NewClient("", "SENDBIRD_API_TOKEN"); err == nil {
+		t.Errorf("NewClient without app id should fail")
+	}
+
+	if _, err := NewClient("SENDBIRD_APP_ID", ""); err == nil {
+		t.Errorf("NewClient without api token should fail")
+	}
+}
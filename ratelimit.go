@@ -0,0 +1,204 @@
+package sendbird
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoint families used as rate limiter buckets.
+const (
+	EndpointUser          = "/user"
+	EndpointChannel       = "/channel"
+	EndpointMessaging     = "/messaging"
+	EndpointAdmin         = "/admin"
+	EndpointBots          = "/v2/bots"
+	EndpointUsersV3       = "/v3/users"
+	EndpointGroupChannels = "/v3/group_channels"
+	EndpointOpenChannels  = "/v3/open_channels"
+)
+
+// ErrRateLimitExceeded is returned by Do when the client side rate limiter fails fast and the endpoint family
+// has no budget left.
+var ErrRateLimitExceeded = errors.New("sendbird: client rate limit exceeded")
+
+// EndpointFamily returns the family an API path belongs to, e.g. "/channel" for "/channel/send" or "/v2/bots"
+// for "/v2/bots/helper_bot/send". Versioned paths keep their version prefix.
+func EndpointFamily(path string) string {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(parts) >= 2 && len(parts[0]) > 1 && parts[0][0] == 'v' && isDigits(parts[0][1:]) {
+		return "/" + parts[0] + "/" + parts[1]
+	}
+	return "/" + parts[0]
+}
+
+type familyKey struct{}
+
+// endpointFamily returns the family of u, a URL resolved against the base URL. The base URL's path is not part
+// of the API path, so "https://gw/sendbird/v3/users/john" is in "/v3/users" behind "https://gw/sendbird/".
+func (c *SendbirdClient) endpointFamily(u *url.URL) string {
+	path := u.Path
+	if prefix := strings.TrimSuffix(c.BaseURL.Path, "/"); prefix != "" && strings.HasPrefix(path, prefix+"/") {
+		path = path[len(prefix):]
+	}
+	return EndpointFamily(path)
+}
+
+// RequestEndpointFamily returns the endpoint family of req as recorded by NewRequest, which leaves out the path of
+// the client's base URL. Requests built elsewhere fall back to the family of their URL path. Middleware use it to
+// label calls.
+func RequestEndpointFamily(req *http.Request) string {
+	if family, ok := req.Context().Value(familyKey{}).(string); ok {
+		return family
+	}
+	return EndpointFamily(req.URL.Path)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Limit describes a token bucket: Rate tokens are added every second, up to Burst. A Rate of zero or less
+// means the family is not limited.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Budget is a snapshot of the tokens left for an endpoint family.
+type Budget struct {
+	Family    string
+	Limit     Limit
+	Available float64 // tokens that can be spent right now, may be fractional, +Inf when Unlimited
+	Unlimited bool    // the family has no limit, see Limit
+}
+
+// RateLimiter is a client side token bucket limiter with one bucket per endpoint family. Install it with
+// SetRateLimiter so that Do waits for (or, with FailFast, refuses) calls that would exceed the budget.
+type RateLimiter struct {
+	// Return ErrRateLimitExceeded instead of blocking when a bucket is empty. Set before first use.
+	FailFast bool
+
+	mu      sync.Mutex
+	def     Limit
+	limits  map[string]Limit
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter applying defaultLimit to every endpoint family. Use SetLimit to give a
+// family its own budget.
+func NewRateLimiter(defaultLimit Limit) *RateLimiter {
+	return &RateLimiter{
+		def:     defaultLimit,
+		limits:  map[string]Limit{},
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// SetLimit overrides the limit of an endpoint family, e.g. EndpointMessaging. The family's bucket starts full.
+func (l *RateLimiter) SetLimit(family string, limit Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limits[family] = limit
+	delete(l.buckets, family)
+}
+
+// Budget reports the tokens currently available for an endpoint family, or Unlimited if it has no limit.
+func (l *RateLimiter) Budget(family string) Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(family)
+	budget := Budget{Family: family, Limit: b.limit, Available: b.tokens}
+	if b.limit.Rate <= 0 {
+		budget.Available = math.Inf(1)
+		budget.Unlimited = true
+	}
+	return budget
+}
+
+// Wait takes one token from the family's bucket, blocking until one is available or ctx is done. With FailFast
+// set it returns ErrRateLimitExceeded instead of blocking.
+func (l *RateLimiter) Wait(ctx context.Context, family string) error {
+	for {
+		l.mu.Lock()
+		b := l.bucket(family)
+		if b.limit.Rate <= 0 {
+			l.mu.Unlock()
+			return nil
+		}
+		if b.tokens >= 1 {
+			b.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		if l.FailFast {
+			l.mu.Unlock()
+			return ErrRateLimitExceeded
+		}
+		wait := time.Duration((1 - b.tokens) / b.limit.Rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// bucket returns the refilled bucket of a family, creating it full on first use. l.mu must be held.
+func (l *RateLimiter) bucket(family string) *bucket {
+	now := l.now()
+
+	b, ok := l.buckets[family]
+	if !ok {
+		limit, ok := l.limits[family]
+		if !ok {
+			limit = l.def
+		}
+		if limit.Burst < 1 {
+			limit.Burst = 1
+		}
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[family] = b
+		return b
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.limit.Rate
+		if max := float64(b.limit.Burst); b.tokens > max {
+			b.tokens = max
+		}
+		b.last = now
+	}
+	return b
+}
+
+// SetRateLimiter installs a client side rate limiter consulted by Do before every attempt. A nil limiter
+// disables client side limiting.
+func (c *SendbirdClient) SetRateLimiter(l *RateLimiter) {
+	c.rateLimiter = l
+}
+
+// RateLimiter returns the limiter installed with SetRateLimiter, so workers can inspect the remaining budget.
+func (c *SendbirdClient) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
@@ -0,0 +1,146 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	cases := map[string]string{
+		"/user/create":                EndpointUser,
+		"/channel/send":               EndpointChannel,
+		"/messaging/invite":           EndpointMessaging,
+		"/admin/read_messages":        EndpointAdmin,
+		"/v2/bots":                    EndpointBots,
+		"/v2/bots/helper_bot/send":    EndpointBots,
+		"/v3/group_channels/abc/hide": "/v3/group_channels",
+		"/":                           "/",
+	}
+
+	for path, expected := range cases {
+		if family := EndpointFamily(path); family != expected {
+			t.Errorf("EndpointFamily(%q) = %q, expected %q", path, family, expected)
+		}
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewRateLimiter(Limit{Rate: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+	l.FailFast = true
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx, EndpointUser); err != nil {
+			t.Fatalf("Wait #%d returned error: %v", i, err)
+		}
+	}
+
+	if err := l.Wait(ctx, EndpointUser); err != ErrRateLimitExceeded {
+		t.Errorf("Wait on an empty bucket returned %v, expected %v", err, ErrRateLimitExceeded)
+	}
+
+	// buckets are independent per family
+	if err := l.Wait(ctx, EndpointChannel); err != nil {
+		t.Errorf("Wait on another family returned error: %v", err)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if b := l.Budget(EndpointUser); b.Available != 1 {
+		t.Errorf("Budget after 500ms = %+v, expected 1 token available", b)
+	}
+}
+
+func TestRateLimiterSetLimit(t *testing.T) {
+	l := NewRateLimiter(Limit{Rate: 1, Burst: 1})
+	l.SetLimit(EndpointMessaging, Limit{Rate: 10, Burst: 5})
+
+	if b := l.Budget(EndpointMessaging); b.Available != 5 || b.Limit.Rate != 10 {
+		t.Errorf("Budget(%q) = %+v", EndpointMessaging, b)
+	}
+
+	if b := l.Budget(EndpointAdmin); b.Available != 1 || b.Limit.Rate != 1 || b.Unlimited {
+		t.Errorf("Budget(%q) = %+v", EndpointAdmin, b)
+	}
+
+	l.SetLimit(EndpointBots, Limit{})
+	if b := l.Budget(EndpointBots); !b.Unlimited || !math.IsInf(b.Available, 1) {
+		t.Errorf("Budget(%q) = %+v, expected an unlimited budget", EndpointBots, b)
+	}
+}
+
+func TestRateLimiterBlocksUntilContextDone(t *testing.T) {
+	l := NewRateLimiter(Limit{Rate: 0.001, Burst: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx, EndpointAdmin); err != nil {
+		t.Fatalf("first Wait returned error: %v", err)
+	}
+
+	if err := l.Wait(ctx, EndpointAdmin); err != context.DeadlineExceeded {
+		t.Errorf("Wait returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDoRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	limiter := NewRateLimiter(Limit{})
+	limiter.SetLimit(EndpointBots, Limit{Rate: 0.001, Burst: 1})
+	limiter.FailFast = true
+	client.SetRateLimiter(limiter)
+
+	calls := 0
+	mux.HandleFunc("/v2/bots/helper_bot", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"bot_userid": "helper_bot"}`)
+	})
+
+	if _, _, err := client.Bot.Get("helper_bot"); err != nil {
+		t.Fatalf("Bot.Get returned error: %v", err)
+	}
+
+	if _, _, err := client.Bot.Get("helper_bot"); err != ErrRateLimitExceeded {
+		t.Errorf("Bot.Get returned %v, expected %v", err, ErrRateLimitExceeded)
+	}
+
+	if calls != 1 {
+		t.Errorf("server received %d calls, expected 1", calls)
+	}
+
+	if client.RateLimiter() != limiter {
+		t.Errorf("RateLimiter did not return the installed limiter")
+	}
+}
+
+func TestDoRateLimitedBehindPathPrefix(t *testing.T) {
+	setup()
+	defer teardown()
+
+	prefixed, _ := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithBaseURL(server.URL+"/sendbird/"))
+	limiter := NewRateLimiter(Limit{})
+	limiter.SetLimit(EndpointUsersV3, Limit{Rate: 0.001, Burst: 1})
+	limiter.FailFast = true
+	prefixed.SetRateLimiter(limiter)
+
+	mux.HandleFunc("/sendbird/v3/users/john", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		fmt.Fprint(w, `{"user_id": "john"}`)
+	})
+
+	if _, _, err := prefixed.UsersV3.Update("john", &UserV3UpdateRequest{Nickname: "John"}); err != nil {
+		t.Fatalf("UserV3.Update returned error: %v", err)
+	}
+
+	if _, _, err := prefixed.UsersV3.Get("john"); err != ErrRateLimitExceeded {
+		t.Errorf("UserV3.Get returned %v, expected %v", err, ErrRateLimitExceeded)
+	}
+}
@@ -0,0 +1,213 @@
+package sendbird
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryMinBackoff = 250 * time.Millisecond
+	defaultRetryMaxBackoff = 5 * time.Second
+)
+
+// RetryPolicy controls how Do retries calls that fail with a network error, a 5xx or a 429 response.
+// Only idempotent HTTP methods are retried unless the request context was marked with WithRetry, since most
+// of the Sendbird API is made of POST endpoints that are not safe to replay blindly.
+type RetryPolicy struct {
+	// Total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// Delay before the first retry. Each following retry doubles it, with jitter, up to MaxBackoff.
+	MinBackoff time.Duration
+
+	// Upper bound for the delay between attempts. A shorter Retry-After header sent by Sendbird takes precedence
+	// over the computed backoff, a longer one stops the retries and Do returns the response as is.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns a policy with three attempts and a 250ms to 5s backoff.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+type retryKey struct{}
+
+// WithRetry returns a copy of ctx that marks the calls made with it as safe to retry, even when they use a
+// non-idempotent HTTP method such as POST.
+func WithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryKey{}, true)
+}
+
+// SetRetryPolicy sets the policy used by Do to retry transient failures. A nil policy disables retries.
+func (c *SendbirdClient) SetRetryPolicy(p *RetryPolicy) {
+	c.retryPolicy = p
+}
+
+// attempts returns how many times req may be sent under the policy.
+func (p *RetryPolicy) attempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 2 {
+		return 1
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the body can't be replayed
+		return 1
+	}
+	if marked, _ := req.Context().Value(retryKey{}).(bool); marked || isIdempotent(req.Method) {
+		return p.MaxAttempts
+	}
+	return 1
+}
+
+// backoff returns how long to wait before the given retry (1 for the first retry). ok is false when the response
+// asks for a longer wait than MaxBackoff, in which case the call is not retried.
+func (p *RetryPolicy) backoff(retry int, resp *http.Response) (d time.Duration, ok bool) {
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = defaultRetryMinBackoff
+	}
+	if max < min {
+		max = min
+	}
+
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d, d <= max
+		}
+	}
+
+	d = min
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// equal jitter: keep half of the delay and randomise the rest
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)), true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "TRACE", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// isRetryable reports whether the outcome of an attempt is worth retrying.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// send performs req, retrying transient failures according to the client's retry policy. The JSON body built
+// by NewRequest is replayed through req.GetBody on every retry.
+func (c *SendbirdClient) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempts := c.retryPolicy.attempts(req)
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx, RequestEndpointFamily(r)); err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		resp, err := c.client.Do(r)
+		if c.logger != nil {
+			c.logAttempt(r, resp, err, attempt, time.Since(start))
+		}
+		if err == nil && c.onRequestCompleted != nil {
+			c.onRequestCompleted(r, resp)
+		}
+
+		if attempt >= attempts || ctx.Err() != nil || !isRetryable(resp, err) {
+			return resp, err
+		}
+
+		wait, ok := c.retryPolicy.backoff(attempt, resp)
+		if !ok {
+			// Sendbird wants us to back off for longer than the policy allows
+			return resp, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// no time left for another attempt, hand back what we have
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,187 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	mux.HandleFunc("/v2/bots/helper_bot", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"bot_userid": "helper_bot"}`)
+	})
+
+	bot, _, err := client.Bot.Get("helper_bot")
+	if err != nil {
+		t.Fatalf("Bot.Get returned error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("server received %d calls, expected 3", calls)
+	}
+
+	if bot.BotUserId != "helper_bot" {
+		t.Errorf("Bot.Get returned %+v", bot)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	mux.HandleFunc("/v2/bots/helper_bot", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, resp, err := client.Bot.Get("helper_bot")
+	if err == nil {
+		t.Fatalf("Bot.Get expected an error")
+	}
+
+	if calls != 3 {
+		t.Errorf("server received %d calls, expected 3", calls)
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Bot.Get returned response %+v, expected status %d", resp, http.StatusBadGateway)
+	}
+}
+
+func TestRetrySkipsUnmarkedPost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	mux.HandleFunc("/user/block", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.Users.Block(&BlockRequest{Id: "1", TargetId: "2"})
+	if err == nil {
+		t.Fatalf("User.Block expected an error")
+	}
+
+	if calls != 1 {
+		t.Errorf("server received %d calls, expected 1", calls)
+	}
+}
+
+func TestRetryMarkedPostReplaysBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	var bodies []string
+	mux.HandleFunc("/user/block", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := WithRetry(context.Background())
+	_, err := client.Users.BlockWithContext(ctx, &BlockRequest{Id: "1", TargetId: "2"})
+	if err != nil {
+		t.Fatalf("User.BlockWithContext returned error: %v", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("server received %d calls, expected 2", len(bodies))
+	}
+
+	if bodies[0] == "" || bodies[0] != bodies[1] {
+		t.Errorf("retried body %q does not match original %q", bodies[1], bodies[0])
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for retry, max := range map[int]time.Duration{1: 100, 2: 200, 3: 300, 4: 300} {
+		max *= time.Millisecond
+		d, ok := p.backoff(retry, nil)
+		if !ok || d < max/2 || d > max {
+			t.Errorf("backoff(%d) = %v, expected between %v and %v", retry, d, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if _, ok := p.backoff(1, resp); ok {
+		t.Errorf("backoff accepted a Retry-After above MaxBackoff")
+	}
+
+	p.MaxBackoff = 10 * time.Second
+	if d, ok := p.backoff(1, resp); !ok || d != 7*time.Second {
+		t.Errorf("backoff with Retry-After = %v, %v, expected 7s", d, ok)
+	}
+}
+
+func TestRetryAfterAboveMaxBackoff(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SetRetryPolicy(testRetryPolicy())
+
+	calls := 0
+	mux.HandleFunc("/v2/bots", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, resp, err := client.Bot.List()
+	if err == nil {
+		t.Errorf("Bot.List returned no error for a 503 response")
+	}
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Bot.List returned %+v, expected the 503 response", resp)
+	}
+
+	if calls != 1 {
+		t.Errorf("server received %d calls, expected 1", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("parseRetryAfter(\"3\") = %v, %v", d, ok)
+	}
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date); !ok || d <= 0 || d > time.Hour {
+		t.Errorf("parseRetryAfter(%q) = %v, %v", date, d, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Errorf("parseRetryAfter(\"soon\") should fail")
+	}
+}
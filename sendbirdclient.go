@@ -2,36 +2,86 @@ package sendbird
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	defaultBaseURL = "https://api.sendbird.com"
 	contentType    = "application/json, charset=utf8"
+
+	defaultUserAgent = "go-sendbird"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRequestID     = "X-Request-Id"
 )
 
 // -----------------------
-// Response is a Sendbird response. This wraps the standard http.Response returned from Sendbird.
+// Response is a Sendbird response. This wraps the standard http.Response returned from Sendbird and adds the
+// metadata parsed from it.
 type Response struct {
 	*http.Response
+
+	// Rate limit reported by Sendbird, zero when the headers are absent
+	Rate Rate
+
+	// Request ID assigned by Sendbird, useful when reporting issues
+	RequestID string
+
+	// Time from sending the request (including retries) until the response headers arrived
+	Latency time.Duration
+}
+
+// Rate represents the rate limit headers of a response.
+type Rate struct {
+	// The number of requests allowed in the current window
+	Limit int
+
+	// The number of requests left in the current window
+	Remaining int
+
+	// The time at which the current window resets
+	Reset time.Time
 }
 
-// An ErrorResponse reports the error caused by an API request
+// An ErrorResponse reports the error caused by an API request. It can be inspected with errors.Is against the
+// sentinel errors of this package, e.g. errors.Is(err, sendbird.ErrChannelNotFound).
 type ErrorResponse struct {
 	// HTTP response that caused this error
-	Response *http.Response
+	Response *http.Response `json:"-"`
 
 	// Error message
-	Message string
+	Message string `json:"message"`
+
+	// Sendbird error code, e.g. 400201. Zero when the body carried none.
+	Code int `json:"code"`
+
+	// Error flag set by Sendbird on error bodies
+	IsError bool `json:"error"`
+
+	// Raw response body, kept for debugging
+	Body []byte `json:"-"`
 }
 
 func (r *ErrorResponse) Error() string {
+	if r.Code != 0 {
+		return fmt.Sprintf("%v %v: %d %v (code %d)",
+			r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message, r.Code)
+	}
 	return fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message)
 }
@@ -51,31 +101,75 @@ type SendbirdClient struct {
 	ApiToken    string
 	ContentType string
 
+	// User agent sent with every request
+	UserAgent string
+
 	// Services used for communicating with the API
-	Users     UserService
-	Chat      ChatChannelService
-	Messaging MessagingChannelService
-	Admin     AdminService
-	Bot       BotService
+	Users         UserService
+	Chat          ChatChannelService
+	Messaging     MessagingChannelService
+	Admin         AdminService
+	Bot           BotService
+	UsersV3       UserV3Service
+	GroupChannels GroupChannelService
+	OpenChannels  OpenChannelService
+	Messages      MessageService
+
+	// How the API token is sent, LegacyBodyAuth when nil
+	auth AuthStrategy
 
 	// Optional function called after every successful request made to the DO APIs
 	onRequestCompleted RequestCompletionCallback
+
+	// Optional policy used to retry transient failures, nil means a single attempt
+	retryPolicy *RetryPolicy
+
+	// Optional client side limiter consulted before every attempt
+	rateLimiter *RateLimiter
+
+	// Optional logger reporting every HTTP attempt, and whether to include bodies
+	logger    *slog.Logger
+	logBodies bool
+
+	// Middleware run around every call made by Do
+	middleware []Middleware
+
+	// Timeout applied to the HTTP client once all options are set
+	timeout time.Duration
 }
 
-// NewClient returns a new Sendbird API client.
-func NewClient(appId string, apiToken string, httpClient *http.Client) *SendbirdClient {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+// NewClient returns a new Sendbird API client configured by opts. It returns an error when the credentials are
+// missing or an option is invalid.
+func NewClient(appId string, apiToken string, opts ...ClientOption) (*SendbirdClient, error) {
+	if appId == "" {
+		return nil, errors.New("sendbird: app id is required")
+	}
+	if apiToken == "" {
+		return nil, errors.New("sendbird: api token is required")
 	}
 
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &SendbirdClient{
-		client:      httpClient,
+		client:      http.DefaultClient,
 		BaseURL:     baseURL,
 		AppId:       appId,
 		ApiToken:    apiToken,
 		ContentType: contentType,
+		UserAgent:   defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.timeout > 0 {
+		// copy so a shared client such as http.DefaultClient isn't modified
+		hc := *c.client
+		hc.Timeout = c.timeout
+		c.client = &hc
 	}
 
 	c.Users = &UserServiceOp{client: c}
@@ -83,8 +177,12 @@ func NewClient(appId string, apiToken string, httpClient *http.Client) *Sendbird
 	c.Messaging = &MessagingChannelServiceOp{client: c}
 	c.Admin = &AdminServiceOp{client: c}
 	c.Bot = &BotServiceOp{client: c}
+	c.UsersV3 = &UserV3ServiceOp{client: c}
+	c.GroupChannels = &GroupChannelServiceOp{client: c}
+	c.OpenChannels = &OpenChannelServiceOp{client: c}
+	c.Messages = &MessageServiceOp{client: c}
 
-	return c
+	return c, nil
 }
 
 func (c *SendbirdClient) NormalizeId(Id string) string {
@@ -100,6 +198,12 @@ func (c *SendbirdClient) NormalizeId(Id string) string {
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
 // value pointed to by body is JSON encoded and included in as the request body.
 func (c *SendbirdClient) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext is like NewRequest but the returned request carries ctx, so cancelling ctx or letting its
+// deadline pass aborts the call made by Do. The request is authenticated by the client's AuthStrategy.
+func (c *SendbirdClient) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -115,12 +219,19 @@ func (c *SendbirdClient) NewRequest(method, urlStr string, body interface{}) (*h
 		}
 	}
 
-	req, err := http.NewRequest(method, url.String(), buf)
+	ctx = context.WithValue(ctx, familyKey{}, c.endpointFamily(url))
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), buf)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-Type", c.ContentType)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if err := c.authStrategy().Authenticate(req, c.ApiToken); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -133,30 +244,49 @@ func (c *SendbirdClient) OnRequestCompleted(rc RequestCompletionCallback) {
 // newResponse creates a new Response for the provided http.Response
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
-	// response.populateRate()
+	response.populateRate()
+	response.RequestID = r.Header.Get(headerRequestID)
 
 	return &response
 }
 
+// populateRate parses the rate limit headers. Reset is accepted as a Unix timestamp in seconds or milliseconds.
+func (r *Response) populateRate() {
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		r.Rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, err := strconv.ParseInt(reset, 10, 64); err == nil && v > 0 {
+			if v > 1e12 {
+				r.Rate.Reset = time.Unix(0, v*int64(time.Millisecond))
+			} else {
+				r.Rate.Reset = time.Unix(v, 0)
+			}
+		}
+	}
+}
+
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
 // error if it has a status code outside the 200 range. API error responses are expected to have either no response
-// body, or a JSON response body that maps to ErrorResponse. Any other response body will be silently ignored.
+// body, or a JSON response body that maps to ErrorResponse. The raw body is always kept in ErrorResponse.Body.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
 		return nil
 	}
 
-	if r.StatusCode == 404 {
-		return &ErrorResponse{Response: r, Message: "Resouce Not Found - please check the URL"}
-	}
-
 	errorResponse := &ErrorResponse{Response: r}
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
-		err := json.Unmarshal(data, errorResponse)
-		if err != nil {
-			return err
-		}
+		errorResponse.Body = data
+		// a body that isn't JSON is only kept raw
+		json.Unmarshal(data, errorResponse)
+	}
+
+	if r.StatusCode == 404 && errorResponse.Message == "" {
+		errorResponse.Message = "Resouce Not Found - please check the URL"
 	}
 
 	return errorResponse
@@ -165,14 +295,18 @@ func CheckResponse(r *http.Response) error {
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+// The request goes through the middleware installed with Use. Transient failures are retried according to the
+// policy set with SetRetryPolicy, and every attempt first takes a token from the limiter set with SetRateLimiter.
 func (c *SendbirdClient) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	start := time.Now()
+	resp, err := c.roundTrip(req)
 	if err != nil {
+		// prefer the context's error so callers can test for context.Canceled / DeadlineExceeded
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
-	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, resp)
-	}
 
 	defer func() {
 		if rerr := resp.Body.Close(); err == nil {
@@ -181,6 +315,7 @@ func (c *SendbirdClient) Do(req *http.Request, v interface{}) (*Response, error)
 	}()
 
 	response := newResponse(resp)
+	response.Latency = time.Since(start)
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -204,3 +339,43 @@ func (c *SendbirdClient) Do(req *http.Request, v interface{}) (*Response, error)
 
 	return response, err
 }
+
+// call builds a request with ctx, sends it with Do and decodes the response into v, when not nil.
+func (c *SendbirdClient) call(ctx context.Context, method, path string, body, v interface{}) (*Response, error) {
+	req, err := c.NewRequestWithContext(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req, v)
+}
+
+// v3Path returns the path of a Platform API v3 resource, e.g. v3Path("users", "john", "token"), with every
+// segment escaped.
+func v3Path(collection string, elems ...string) string {
+	path := "v3/" + collection
+	for _, e := range elems {
+		path += "/" + url.PathEscape(e)
+	}
+	return path
+}
+
+// metadataRequest is the body of the v3 requests creating or updating the metadata of a resource.
+type metadataRequest struct {
+	Metadata map[string]string `json:"metadata"`
+	Upsert   bool              `json:"upsert,omitempty"`
+}
+
+// callMetadata is like call for the v3 requests answered with the metadata of a resource.
+func (c *SendbirdClient) callMetadata(ctx context.Context, method, path string, body interface{}) (map[string]string, *Response, error) {
+	metadata := map[string]string{}
+	resp, err := c.call(ctx, method, path, body, &metadata)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metadata, resp, nil
+}
+
+// DoWithContext is like Do but sends req with ctx in place of the context it was built with.
+func (c *SendbirdClient) DoWithContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	return c.Do(req.WithContext(ctx), v)
+}
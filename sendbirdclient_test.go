@@ -1,11 +1,12 @@
 package sendbird
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -20,9 +21,7 @@ func setup() {
 	mux = http.NewServeMux()
 	server = httptest.NewServer(mux)
 
-	client = NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", nil)
-	url, _ := url.Parse(server.URL)
-	client.BaseURL = url
+	client, _ = NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", WithBaseURL(server.URL))
 }
 
 func teardown() {
@@ -36,7 +35,10 @@ func testMethod(t *testing.T, r *http.Request, expected string) {
 }
 
 func TestNewClient(t *testing.T) {
-	c := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID", nil)
+	c, err := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
 
 	if c.BaseURL.String() != defaultBaseURL {
 		t.Errorf("NewClient BaseURL = %v, expected %v", c.BaseURL.String(), defaultBaseURL)
@@ -62,11 +64,106 @@ func CheckForV2ApiTokenParam(t *testing.T, r *http.Request, d interface{}) {
 		t.Errorf("Required request parameter of api_token not populated")
 	}
 }
-func CheckForV2ApiTokenQueryString(t *testing.T, r *http.Request) {
-	if err := r.ParseForm(); err != nil {
-		t.Errorf("Bot.Get - Unable to parse querystring", err)
+
+// CheckForNoQueryToken checks that a request doesn't carry the API token in its query string.
+func CheckForNoQueryToken(t *testing.T, r *http.Request) {
+	for _, field := range []string{"auth", "api_token"} {
+		if _, ok := r.URL.Query()[field]; ok {
+			t.Errorf("Querystring parameter %s must not be set", field)
+		}
+	}
+}
+
+// CheckForHeaderAuth checks that a request carries the API token in its header, and neither in its query string
+// nor in the body decoded in d, when not nil.
+func CheckForHeaderAuth(t *testing.T, r *http.Request, d interface{}) {
+	CheckForApiTokenHeader(t, r)
+	CheckForNoQueryToken(t, r)
+
+	if d == nil {
+		return
+	}
+	for _, field := range []string{"Auth", "ApiToken"} {
+		if v := reflect.ValueOf(d).FieldByName(field); v.IsValid() && v.String() != "" {
+			t.Errorf("Request parameter %s must not be populated", field)
+		}
+	}
+}
+
+func CheckForApiTokenHeader(t *testing.T, r *http.Request) {
+	if r.Header.Get("Api-Token") != client.ApiToken {
+		t.Errorf("Api-Token Request Header should be set to the client's api token")
+	}
+}
+
+func TestNewRequestWithContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	c, _ := NewClient("SENDBIRD_API_TOKEN", "SENDBIRD_APP_ID")
+	req, err := c.NewRequestWithContext(ctx, "POST", "/user/create", nil)
+	if err != nil {
+		t.Fatalf("NewRequestWithContext returned error: %v", err)
+	}
+
+	if req.Context().Value(key{}) != "value" {
+		t.Errorf("NewRequestWithContext did not attach the supplied context")
+	}
+}
+
+func TestDoWithContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/create", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request should not reach the server once the context is canceled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("POST", "/user/create", nil)
+	_, err := client.DoWithContext(ctx, req, nil)
+	if err != context.Canceled {
+		t.Errorf("DoWithContext returned %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestResponseMetadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ccu_count", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Write([]byte(`{"count": 1}`))
+	})
+
+	_, resp, err := client.Admin.ConcurrentUserCount()
+	if err != nil {
+		t.Fatalf("Admin.ConcurrentUserCount returned error: %v", err)
 	}
-	if r.Form.Get("api_token") == "" {
-		t.Errorf("Required querystring parameter of api_token not populated")
+
+	expected := Rate{Limit: 100, Remaining: 42, Reset: time.Unix(1700000000, 0)}
+	if !reflect.DeepEqual(resp.Rate, expected) {
+		t.Errorf("Response.Rate = %+v, expected %+v", resp.Rate, expected)
+	}
+
+	if resp.RequestID != "req-123" {
+		t.Errorf("Response.RequestID = %q, expected %q", resp.RequestID, "req-123")
+	}
+
+	if resp.Latency <= 0 {
+		t.Errorf("Response.Latency = %v, expected a positive duration", resp.Latency)
+	}
+}
+
+func TestResponseRateResetMilliseconds(t *testing.T) {
+	r := newResponse(&http.Response{Header: http.Header{"X-Ratelimit-Reset": []string{"1700000000123"}}})
+
+	if expected := time.Unix(1700000000, 123*int64(time.Millisecond)); !r.Rate.Reset.Equal(expected) {
+		t.Errorf("Rate.Reset = %v, expected %v", r.Rate.Reset, expected)
 	}
 }
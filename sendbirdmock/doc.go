@@ -0,0 +1,16 @@
+// Package sendbirdmock provides mocks of the service interfaces of package sendbird, with configurable return
+// values, call recording and assertion helpers.
+//
+//	users := &sendbirdmock.UserService{
+//		CreateFunc: func(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+//			return &sendbird.User{Id: params.Id}, nil, nil
+//		},
+//	}
+//	sb.Users = users
+//	...
+//	users.AssertCalled(t, "Create", &sendbird.UserRequest{Id: "john"})
+//
+// The mocks are generated from the interfaces; run go generate after changing them.
+package sendbirdmock
+
+//go:generate go run gen.go
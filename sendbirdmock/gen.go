@@ -0,0 +1,21 @@
+//go:build ignore
+
+// gen writes mocks_gen.go from the service interfaces of package sendbird.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/ippy04/sendbird/sendbirdmock/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("mocks_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,3145 @@
+// Code generated by mockgen; DO NOT EDIT.
+
+package sendbirdmock
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ippy04/sendbird"
+)
+
+// AdminService is a mock of sendbird.AdminService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type AdminService struct {
+	Recorder
+
+	BroadcastMessageFunc                 func(params *sendbird.BroadcastMessageRequest) (*sendbird.Response, error)
+	BroadcastMessageWithContextFunc      func(ctx context.Context, params *sendbird.BroadcastMessageRequest) (*sendbird.Response, error)
+	ReadMessagesFunc                     func(params *sendbird.ReadMessagesRequest) ([]sendbird.AdminMessage, *sendbird.Response, error)
+	ReadMessagesWithContextFunc          func(ctx context.Context, params *sendbird.ReadMessagesRequest) ([]sendbird.AdminMessage, *sendbird.Response, error)
+	DeleteMessageFunc                    func(messageId string) (*sendbird.DeleteMessage, *sendbird.Response, error)
+	DeleteMessageWithContextFunc         func(ctx context.Context, messageId string) (*sendbird.DeleteMessage, *sendbird.Response, error)
+	ListMessagingChannelsFunc            func(userId string) ([]sendbird.AdminMessagingChannel, *sendbird.Response, error)
+	ListMessagingChannelsWithContextFunc func(ctx context.Context, userId string) ([]sendbird.AdminMessagingChannel, *sendbird.Response, error)
+	MuteAllChannelsFunc                  func(userId string) (*sendbird.Response, error)
+	MuteAllChannelsWithContextFunc       func(ctx context.Context, userId string) (*sendbird.Response, error)
+	MuteFunc                             func(params *sendbird.MuteRequest) ([]string, *sendbird.Response, error)
+	MuteWithContextFunc                  func(ctx context.Context, params *sendbird.MuteRequest) ([]string, *sendbird.Response, error)
+	UnMuteAllChannelsFunc                func(userId string) (*sendbird.Response, error)
+	UnMuteAllChannelsWithContextFunc     func(ctx context.Context, userId string) (*sendbird.Response, error)
+	UnMuteFunc                           func(params *sendbird.UnMuteRequest) ([]string, *sendbird.Response, error)
+	UnMuteWithContextFunc                func(ctx context.Context, params *sendbird.UnMuteRequest) ([]string, *sendbird.Response, error)
+	MuteListFunc                         func(channelUrls []string) ([]string, *sendbird.Response, error)
+	MuteListWithContextFunc              func(ctx context.Context, channelUrls []string) ([]string, *sendbird.Response, error)
+	ConcurrentUserCountFunc              func() (*sendbird.ConcurrentUserCount, *sendbird.Response, error)
+	ConcurrentUserCountWithContextFunc   func(ctx context.Context) (*sendbird.ConcurrentUserCount, *sendbird.Response, error)
+	MemberCountInChannelFunc             func(channelUrl string) (*sendbird.ChannelMemberCount, *sendbird.Response, error)
+	MemberCountInChannelWithContextFunc  func(ctx context.Context, channelUrl string) (*sendbird.ChannelMemberCount, *sendbird.Response, error)
+}
+
+var _ sendbird.AdminService = &AdminService{}
+
+// BroadcastMessage records the call and calls BroadcastMessageFunc.
+func (m *AdminService) BroadcastMessage(params *sendbird.BroadcastMessageRequest) (*sendbird.Response, error) {
+	m.record("BroadcastMessage", nil, params)
+	if m.BroadcastMessageFunc != nil {
+		return m.BroadcastMessageFunc(params)
+	}
+	if m.BroadcastMessageWithContextFunc != nil {
+		return m.BroadcastMessageWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// BroadcastMessageWithContext records the call and calls BroadcastMessageWithContextFunc.
+func (m *AdminService) BroadcastMessageWithContext(ctx context.Context, params *sendbird.BroadcastMessageRequest) (*sendbird.Response, error) {
+	m.record("BroadcastMessageWithContext", ctx, params)
+	if m.BroadcastMessageWithContextFunc != nil {
+		return m.BroadcastMessageWithContextFunc(ctx, params)
+	}
+	if m.BroadcastMessageFunc != nil {
+		return m.BroadcastMessageFunc(params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// ReadMessages records the call and calls ReadMessagesFunc.
+func (m *AdminService) ReadMessages(params *sendbird.ReadMessagesRequest) ([]sendbird.AdminMessage, *sendbird.Response, error) {
+	m.record("ReadMessages", nil, params)
+	if m.ReadMessagesFunc != nil {
+		return m.ReadMessagesFunc(params)
+	}
+	if m.ReadMessagesWithContextFunc != nil {
+		return m.ReadMessagesWithContextFunc(context.Background(), params)
+	}
+	var r0 []sendbird.AdminMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ReadMessagesWithContext records the call and calls ReadMessagesWithContextFunc.
+func (m *AdminService) ReadMessagesWithContext(ctx context.Context, params *sendbird.ReadMessagesRequest) ([]sendbird.AdminMessage, *sendbird.Response, error) {
+	m.record("ReadMessagesWithContext", ctx, params)
+	if m.ReadMessagesWithContextFunc != nil {
+		return m.ReadMessagesWithContextFunc(ctx, params)
+	}
+	if m.ReadMessagesFunc != nil {
+		return m.ReadMessagesFunc(params)
+	}
+	var r0 []sendbird.AdminMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteMessage records the call and calls DeleteMessageFunc.
+func (m *AdminService) DeleteMessage(messageId string) (*sendbird.DeleteMessage, *sendbird.Response, error) {
+	m.record("DeleteMessage", nil, messageId)
+	if m.DeleteMessageFunc != nil {
+		return m.DeleteMessageFunc(messageId)
+	}
+	if m.DeleteMessageWithContextFunc != nil {
+		return m.DeleteMessageWithContextFunc(context.Background(), messageId)
+	}
+	var r0 *sendbird.DeleteMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteMessageWithContext records the call and calls DeleteMessageWithContextFunc.
+func (m *AdminService) DeleteMessageWithContext(ctx context.Context, messageId string) (*sendbird.DeleteMessage, *sendbird.Response, error) {
+	m.record("DeleteMessageWithContext", ctx, messageId)
+	if m.DeleteMessageWithContextFunc != nil {
+		return m.DeleteMessageWithContextFunc(ctx, messageId)
+	}
+	if m.DeleteMessageFunc != nil {
+		return m.DeleteMessageFunc(messageId)
+	}
+	var r0 *sendbird.DeleteMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListMessagingChannels records the call and calls ListMessagingChannelsFunc.
+func (m *AdminService) ListMessagingChannels(userId string) ([]sendbird.AdminMessagingChannel, *sendbird.Response, error) {
+	m.record("ListMessagingChannels", nil, userId)
+	if m.ListMessagingChannelsFunc != nil {
+		return m.ListMessagingChannelsFunc(userId)
+	}
+	if m.ListMessagingChannelsWithContextFunc != nil {
+		return m.ListMessagingChannelsWithContextFunc(context.Background(), userId)
+	}
+	var r0 []sendbird.AdminMessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListMessagingChannelsWithContext records the call and calls ListMessagingChannelsWithContextFunc.
+func (m *AdminService) ListMessagingChannelsWithContext(ctx context.Context, userId string) ([]sendbird.AdminMessagingChannel, *sendbird.Response, error) {
+	m.record("ListMessagingChannelsWithContext", ctx, userId)
+	if m.ListMessagingChannelsWithContextFunc != nil {
+		return m.ListMessagingChannelsWithContextFunc(ctx, userId)
+	}
+	if m.ListMessagingChannelsFunc != nil {
+		return m.ListMessagingChannelsFunc(userId)
+	}
+	var r0 []sendbird.AdminMessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MuteAllChannels records the call and calls MuteAllChannelsFunc.
+func (m *AdminService) MuteAllChannels(userId string) (*sendbird.Response, error) {
+	m.record("MuteAllChannels", nil, userId)
+	if m.MuteAllChannelsFunc != nil {
+		return m.MuteAllChannelsFunc(userId)
+	}
+	if m.MuteAllChannelsWithContextFunc != nil {
+		return m.MuteAllChannelsWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// MuteAllChannelsWithContext records the call and calls MuteAllChannelsWithContextFunc.
+func (m *AdminService) MuteAllChannelsWithContext(ctx context.Context, userId string) (*sendbird.Response, error) {
+	m.record("MuteAllChannelsWithContext", ctx, userId)
+	if m.MuteAllChannelsWithContextFunc != nil {
+		return m.MuteAllChannelsWithContextFunc(ctx, userId)
+	}
+	if m.MuteAllChannelsFunc != nil {
+		return m.MuteAllChannelsFunc(userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Mute records the call and calls MuteFunc.
+func (m *AdminService) Mute(params *sendbird.MuteRequest) ([]string, *sendbird.Response, error) {
+	m.record("Mute", nil, params)
+	if m.MuteFunc != nil {
+		return m.MuteFunc(params)
+	}
+	if m.MuteWithContextFunc != nil {
+		return m.MuteWithContextFunc(context.Background(), params)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MuteWithContext records the call and calls MuteWithContextFunc.
+func (m *AdminService) MuteWithContext(ctx context.Context, params *sendbird.MuteRequest) ([]string, *sendbird.Response, error) {
+	m.record("MuteWithContext", ctx, params)
+	if m.MuteWithContextFunc != nil {
+		return m.MuteWithContextFunc(ctx, params)
+	}
+	if m.MuteFunc != nil {
+		return m.MuteFunc(params)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UnMuteAllChannels records the call and calls UnMuteAllChannelsFunc.
+func (m *AdminService) UnMuteAllChannels(userId string) (*sendbird.Response, error) {
+	m.record("UnMuteAllChannels", nil, userId)
+	if m.UnMuteAllChannelsFunc != nil {
+		return m.UnMuteAllChannelsFunc(userId)
+	}
+	if m.UnMuteAllChannelsWithContextFunc != nil {
+		return m.UnMuteAllChannelsWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UnMuteAllChannelsWithContext records the call and calls UnMuteAllChannelsWithContextFunc.
+func (m *AdminService) UnMuteAllChannelsWithContext(ctx context.Context, userId string) (*sendbird.Response, error) {
+	m.record("UnMuteAllChannelsWithContext", ctx, userId)
+	if m.UnMuteAllChannelsWithContextFunc != nil {
+		return m.UnMuteAllChannelsWithContextFunc(ctx, userId)
+	}
+	if m.UnMuteAllChannelsFunc != nil {
+		return m.UnMuteAllChannelsFunc(userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UnMute records the call and calls UnMuteFunc.
+func (m *AdminService) UnMute(params *sendbird.UnMuteRequest) ([]string, *sendbird.Response, error) {
+	m.record("UnMute", nil, params)
+	if m.UnMuteFunc != nil {
+		return m.UnMuteFunc(params)
+	}
+	if m.UnMuteWithContextFunc != nil {
+		return m.UnMuteWithContextFunc(context.Background(), params)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UnMuteWithContext records the call and calls UnMuteWithContextFunc.
+func (m *AdminService) UnMuteWithContext(ctx context.Context, params *sendbird.UnMuteRequest) ([]string, *sendbird.Response, error) {
+	m.record("UnMuteWithContext", ctx, params)
+	if m.UnMuteWithContextFunc != nil {
+		return m.UnMuteWithContextFunc(ctx, params)
+	}
+	if m.UnMuteFunc != nil {
+		return m.UnMuteFunc(params)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MuteList records the call and calls MuteListFunc.
+func (m *AdminService) MuteList(channelUrls []string) ([]string, *sendbird.Response, error) {
+	m.record("MuteList", nil, channelUrls)
+	if m.MuteListFunc != nil {
+		return m.MuteListFunc(channelUrls)
+	}
+	if m.MuteListWithContextFunc != nil {
+		return m.MuteListWithContextFunc(context.Background(), channelUrls)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MuteListWithContext records the call and calls MuteListWithContextFunc.
+func (m *AdminService) MuteListWithContext(ctx context.Context, channelUrls []string) ([]string, *sendbird.Response, error) {
+	m.record("MuteListWithContext", ctx, channelUrls)
+	if m.MuteListWithContextFunc != nil {
+		return m.MuteListWithContextFunc(ctx, channelUrls)
+	}
+	if m.MuteListFunc != nil {
+		return m.MuteListFunc(channelUrls)
+	}
+	var r0 []string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ConcurrentUserCount records the call and calls ConcurrentUserCountFunc.
+func (m *AdminService) ConcurrentUserCount() (*sendbird.ConcurrentUserCount, *sendbird.Response, error) {
+	m.record("ConcurrentUserCount", nil)
+	if m.ConcurrentUserCountFunc != nil {
+		return m.ConcurrentUserCountFunc()
+	}
+	if m.ConcurrentUserCountWithContextFunc != nil {
+		return m.ConcurrentUserCountWithContextFunc(context.Background())
+	}
+	var r0 *sendbird.ConcurrentUserCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ConcurrentUserCountWithContext records the call and calls ConcurrentUserCountWithContextFunc.
+func (m *AdminService) ConcurrentUserCountWithContext(ctx context.Context) (*sendbird.ConcurrentUserCount, *sendbird.Response, error) {
+	m.record("ConcurrentUserCountWithContext", ctx)
+	if m.ConcurrentUserCountWithContextFunc != nil {
+		return m.ConcurrentUserCountWithContextFunc(ctx)
+	}
+	if m.ConcurrentUserCountFunc != nil {
+		return m.ConcurrentUserCountFunc()
+	}
+	var r0 *sendbird.ConcurrentUserCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MemberCountInChannel records the call and calls MemberCountInChannelFunc.
+func (m *AdminService) MemberCountInChannel(channelUrl string) (*sendbird.ChannelMemberCount, *sendbird.Response, error) {
+	m.record("MemberCountInChannel", nil, channelUrl)
+	if m.MemberCountInChannelFunc != nil {
+		return m.MemberCountInChannelFunc(channelUrl)
+	}
+	if m.MemberCountInChannelWithContextFunc != nil {
+		return m.MemberCountInChannelWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.ChannelMemberCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MemberCountInChannelWithContext records the call and calls MemberCountInChannelWithContextFunc.
+func (m *AdminService) MemberCountInChannelWithContext(ctx context.Context, channelUrl string) (*sendbird.ChannelMemberCount, *sendbird.Response, error) {
+	m.record("MemberCountInChannelWithContext", ctx, channelUrl)
+	if m.MemberCountInChannelWithContextFunc != nil {
+		return m.MemberCountInChannelWithContextFunc(ctx, channelUrl)
+	}
+	if m.MemberCountInChannelFunc != nil {
+		return m.MemberCountInChannelFunc(channelUrl)
+	}
+	var r0 *sendbird.ChannelMemberCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// BotService is a mock of sendbird.BotService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type BotService struct {
+	Recorder
+
+	CreateFunc                 func(params *sendbird.BotRequest) (*sendbird.Bot, *sendbird.Response, error)
+	CreateWithContextFunc      func(ctx context.Context, params *sendbird.BotRequest) (*sendbird.Bot, *sendbird.Response, error)
+	SendMessageFunc            func(botUserId string, params *sendbird.BotMessageRequest) (*sendbird.BotMessage, *sendbird.Response, error)
+	SendMessageWithContextFunc func(ctx context.Context, botUserId string, params *sendbird.BotMessageRequest) (*sendbird.BotMessage, *sendbird.Response, error)
+	ListFunc                   func() ([]sendbird.Bot, *sendbird.Response, error)
+	ListWithContextFunc        func(ctx context.Context) ([]sendbird.Bot, *sendbird.Response, error)
+	GetFunc                    func(botUserId string) (*sendbird.Bot, *sendbird.Response, error)
+	GetWithContextFunc         func(ctx context.Context, botUserId string) (*sendbird.Bot, *sendbird.Response, error)
+	UpdateFunc                 func(botUserId string, params *sendbird.BotUpdateRequest) (*sendbird.Bot, *sendbird.Response, error)
+	UpdateWithContextFunc      func(ctx context.Context, botUserId string, params *sendbird.BotUpdateRequest) (*sendbird.Bot, *sendbird.Response, error)
+	DeleteFunc                 func(botUserId string) (*sendbird.BotUserId, *sendbird.Response, error)
+	DeleteWithContextFunc      func(ctx context.Context, botUserId string) (*sendbird.BotUserId, *sendbird.Response, error)
+	HandlerFunc                func(rw http.ResponseWriter, req *http.Request)
+	ServeHTTPFunc              func(rw http.ResponseWriter, req *http.Request)
+	ShutdownFunc               func(ctx context.Context) error
+}
+
+var _ sendbird.BotService = &BotService{}
+
+// Create records the call and calls CreateFunc.
+func (m *BotService) Create(params *sendbird.BotRequest) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("Create", nil, params)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *BotService) CreateWithContext(ctx context.Context, params *sendbird.BotRequest) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SendMessage records the call and calls SendMessageFunc.
+func (m *BotService) SendMessage(botUserId string, params *sendbird.BotMessageRequest) (*sendbird.BotMessage, *sendbird.Response, error) {
+	m.record("SendMessage", nil, botUserId, params)
+	if m.SendMessageFunc != nil {
+		return m.SendMessageFunc(botUserId, params)
+	}
+	if m.SendMessageWithContextFunc != nil {
+		return m.SendMessageWithContextFunc(context.Background(), botUserId, params)
+	}
+	var r0 *sendbird.BotMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SendMessageWithContext records the call and calls SendMessageWithContextFunc.
+func (m *BotService) SendMessageWithContext(ctx context.Context, botUserId string, params *sendbird.BotMessageRequest) (*sendbird.BotMessage, *sendbird.Response, error) {
+	m.record("SendMessageWithContext", ctx, botUserId, params)
+	if m.SendMessageWithContextFunc != nil {
+		return m.SendMessageWithContextFunc(ctx, botUserId, params)
+	}
+	if m.SendMessageFunc != nil {
+		return m.SendMessageFunc(botUserId, params)
+	}
+	var r0 *sendbird.BotMessage
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *BotService) List() ([]sendbird.Bot, *sendbird.Response, error) {
+	m.record("List", nil)
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 []sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *BotService) ListWithContext(ctx context.Context) ([]sendbird.Bot, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	var r0 []sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Get records the call and calls GetFunc.
+func (m *BotService) Get(botUserId string) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("Get", nil, botUserId)
+	if m.GetFunc != nil {
+		return m.GetFunc(botUserId)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), botUserId)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetWithContext records the call and calls GetWithContextFunc.
+func (m *BotService) GetWithContext(ctx context.Context, botUserId string) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("GetWithContext", ctx, botUserId)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, botUserId)
+	}
+	if m.GetFunc != nil {
+		return m.GetFunc(botUserId)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *BotService) Update(botUserId string, params *sendbird.BotUpdateRequest) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("Update", nil, botUserId, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(botUserId, params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), botUserId, params)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *BotService) UpdateWithContext(ctx context.Context, botUserId string, params *sendbird.BotUpdateRequest) (*sendbird.Bot, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, botUserId, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, botUserId, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(botUserId, params)
+	}
+	var r0 *sendbird.Bot
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *BotService) Delete(botUserId string) (*sendbird.BotUserId, *sendbird.Response, error) {
+	m.record("Delete", nil, botUserId)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(botUserId)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), botUserId)
+	}
+	var r0 *sendbird.BotUserId
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *BotService) DeleteWithContext(ctx context.Context, botUserId string) (*sendbird.BotUserId, *sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, botUserId)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, botUserId)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(botUserId)
+	}
+	var r0 *sendbird.BotUserId
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Handler records the call and calls HandlerFunc.
+func (m *BotService) Handler(rw http.ResponseWriter, req *http.Request) {
+	m.record("Handler", nil, rw, req)
+	if m.HandlerFunc != nil {
+		m.HandlerFunc(rw, req)
+		return
+	}
+}
+
+// ServeHTTP records the call and calls ServeHTTPFunc.
+func (m *BotService) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	m.record("ServeHTTP", nil, rw, req)
+	if m.ServeHTTPFunc != nil {
+		m.ServeHTTPFunc(rw, req)
+		return
+	}
+}
+
+// Shutdown records the call and calls ShutdownFunc.
+func (m *BotService) Shutdown(ctx context.Context) error {
+	m.record("Shutdown", ctx)
+	if m.ShutdownFunc != nil {
+		return m.ShutdownFunc(ctx)
+	}
+	var r0 error
+	return r0
+}
+
+// ChatChannelService is a mock of sendbird.ChatChannelService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type ChatChannelService struct {
+	Recorder
+
+	CreateFunc                         func(arg0 *sendbird.ChatChannelRequest) (*sendbird.ChatChannel, *sendbird.Response, error)
+	CreateWithContextFunc              func(ctx context.Context, params *sendbird.ChatChannelRequest) (*sendbird.ChatChannel, *sendbird.Response, error)
+	ListFunc                           func() ([]sendbird.ChatChannel, *sendbird.Response, error)
+	ListWithContextFunc                func(ctx context.Context) ([]sendbird.ChatChannel, *sendbird.Response, error)
+	UpdateFunc                         func(params *sendbird.ChatChannelUpdateRequest) (*sendbird.ChatChannelUpdate, *sendbird.Response, error)
+	UpdateWithContextFunc              func(ctx context.Context, params *sendbird.ChatChannelUpdateRequest) (*sendbird.ChatChannelUpdate, *sendbird.Response, error)
+	DeleteFunc                         func(channelUrl string) (*sendbird.Response, error)
+	DeleteWithContextFunc              func(ctx context.Context, channelUrl string) (*sendbird.Response, error)
+	ViewFunc                           func(channelUrl string) (*sendbird.ChatChannelView, *sendbird.Response, error)
+	ViewWithContextFunc                func(ctx context.Context, channelUrl string) (*sendbird.ChatChannelView, *sendbird.Response, error)
+	SendFunc                           func(params *sendbird.ChatChannelMessageRequest) (*sendbird.Response, error)
+	SendWithContextFunc                func(ctx context.Context, params *sendbird.ChatChannelMessageRequest) (*sendbird.Response, error)
+	GetMetadataFunc                    func(params *sendbird.ChatChannelMetadataRequest) (map[string]string, *sendbird.Response, error)
+	GetMetadataWithContextFunc         func(ctx context.Context, params *sendbird.ChatChannelMetadataRequest) (map[string]string, *sendbird.Response, error)
+	SetMetadataFunc                    func(params *sendbird.ChatChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error)
+	SetMetadataWithContextFunc         func(ctx context.Context, params *sendbird.ChatChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error)
+	GetMetacounterFunc                 func(params *sendbird.ChatChannelMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	GetMetacounterWithContextFunc      func(ctx context.Context, params *sendbird.ChatChannelMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	SetMetacounterFunc                 func(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	SetMetacounterWithContextFunc      func(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	IncreaseMetacounterFunc            func(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	IncreaseMetacounterWithContextFunc func(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	DecreaseMetacounterFunc            func(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	DecreaseMetacounterWithContextFunc func(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	MessageCountFunc                   func(channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error)
+	MessageCountWithContextFunc        func(ctx context.Context, channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error)
+}
+
+var _ sendbird.ChatChannelService = &ChatChannelService{}
+
+// Create records the call and calls CreateFunc.
+func (m *ChatChannelService) Create(arg0 *sendbird.ChatChannelRequest) (*sendbird.ChatChannel, *sendbird.Response, error) {
+	m.record("Create", nil, arg0)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), arg0)
+	}
+	var r0 *sendbird.ChatChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *ChatChannelService) CreateWithContext(ctx context.Context, params *sendbird.ChatChannelRequest) (*sendbird.ChatChannel, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.ChatChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *ChatChannelService) List() ([]sendbird.ChatChannel, *sendbird.Response, error) {
+	m.record("List", nil)
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background())
+	}
+	var r0 []sendbird.ChatChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *ChatChannelService) ListWithContext(ctx context.Context) ([]sendbird.ChatChannel, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc()
+	}
+	var r0 []sendbird.ChatChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *ChatChannelService) Update(params *sendbird.ChatChannelUpdateRequest) (*sendbird.ChatChannelUpdate, *sendbird.Response, error) {
+	m.record("Update", nil, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.ChatChannelUpdate
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *ChatChannelService) UpdateWithContext(ctx context.Context, params *sendbird.ChatChannelUpdateRequest) (*sendbird.ChatChannelUpdate, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	var r0 *sendbird.ChatChannelUpdate
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *ChatChannelService) Delete(channelUrl string) (*sendbird.Response, error) {
+	m.record("Delete", nil, channelUrl)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *ChatChannelService) DeleteWithContext(ctx context.Context, channelUrl string) (*sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, channelUrl)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, channelUrl)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// View records the call and calls ViewFunc.
+func (m *ChatChannelService) View(channelUrl string) (*sendbird.ChatChannelView, *sendbird.Response, error) {
+	m.record("View", nil, channelUrl)
+	if m.ViewFunc != nil {
+		return m.ViewFunc(channelUrl)
+	}
+	if m.ViewWithContextFunc != nil {
+		return m.ViewWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.ChatChannelView
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ViewWithContext records the call and calls ViewWithContextFunc.
+func (m *ChatChannelService) ViewWithContext(ctx context.Context, channelUrl string) (*sendbird.ChatChannelView, *sendbird.Response, error) {
+	m.record("ViewWithContext", ctx, channelUrl)
+	if m.ViewWithContextFunc != nil {
+		return m.ViewWithContextFunc(ctx, channelUrl)
+	}
+	if m.ViewFunc != nil {
+		return m.ViewFunc(channelUrl)
+	}
+	var r0 *sendbird.ChatChannelView
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Send records the call and calls SendFunc.
+func (m *ChatChannelService) Send(params *sendbird.ChatChannelMessageRequest) (*sendbird.Response, error) {
+	m.record("Send", nil, params)
+	if m.SendFunc != nil {
+		return m.SendFunc(params)
+	}
+	if m.SendWithContextFunc != nil {
+		return m.SendWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// SendWithContext records the call and calls SendWithContextFunc.
+func (m *ChatChannelService) SendWithContext(ctx context.Context, params *sendbird.ChatChannelMessageRequest) (*sendbird.Response, error) {
+	m.record("SendWithContext", ctx, params)
+	if m.SendWithContextFunc != nil {
+		return m.SendWithContextFunc(ctx, params)
+	}
+	if m.SendFunc != nil {
+		return m.SendFunc(params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// GetMetadata records the call and calls GetMetadataFunc.
+func (m *ChatChannelService) GetMetadata(params *sendbird.ChatChannelMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("GetMetadata", nil, params)
+	if m.GetMetadataFunc != nil {
+		return m.GetMetadataFunc(params)
+	}
+	if m.GetMetadataWithContextFunc != nil {
+		return m.GetMetadataWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetadataWithContext records the call and calls GetMetadataWithContextFunc.
+func (m *ChatChannelService) GetMetadataWithContext(ctx context.Context, params *sendbird.ChatChannelMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("GetMetadataWithContext", ctx, params)
+	if m.GetMetadataWithContextFunc != nil {
+		return m.GetMetadataWithContextFunc(ctx, params)
+	}
+	if m.GetMetadataFunc != nil {
+		return m.GetMetadataFunc(params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetadata records the call and calls SetMetadataFunc.
+func (m *ChatChannelService) SetMetadata(params *sendbird.ChatChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("SetMetadata", nil, params)
+	if m.SetMetadataFunc != nil {
+		return m.SetMetadataFunc(params)
+	}
+	if m.SetMetadataWithContextFunc != nil {
+		return m.SetMetadataWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetadataWithContext records the call and calls SetMetadataWithContextFunc.
+func (m *ChatChannelService) SetMetadataWithContext(ctx context.Context, params *sendbird.ChatChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("SetMetadataWithContext", ctx, params)
+	if m.SetMetadataWithContextFunc != nil {
+		return m.SetMetadataWithContextFunc(ctx, params)
+	}
+	if m.SetMetadataFunc != nil {
+		return m.SetMetadataFunc(params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetacounter records the call and calls GetMetacounterFunc.
+func (m *ChatChannelService) GetMetacounter(params *sendbird.ChatChannelMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("GetMetacounter", nil, params)
+	if m.GetMetacounterFunc != nil {
+		return m.GetMetacounterFunc(params)
+	}
+	if m.GetMetacounterWithContextFunc != nil {
+		return m.GetMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetacounterWithContext records the call and calls GetMetacounterWithContextFunc.
+func (m *ChatChannelService) GetMetacounterWithContext(ctx context.Context, params *sendbird.ChatChannelMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("GetMetacounterWithContext", ctx, params)
+	if m.GetMetacounterWithContextFunc != nil {
+		return m.GetMetacounterWithContextFunc(ctx, params)
+	}
+	if m.GetMetacounterFunc != nil {
+		return m.GetMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetacounter records the call and calls SetMetacounterFunc.
+func (m *ChatChannelService) SetMetacounter(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("SetMetacounter", nil, params)
+	if m.SetMetacounterFunc != nil {
+		return m.SetMetacounterFunc(params)
+	}
+	if m.SetMetacounterWithContextFunc != nil {
+		return m.SetMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetacounterWithContext records the call and calls SetMetacounterWithContextFunc.
+func (m *ChatChannelService) SetMetacounterWithContext(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("SetMetacounterWithContext", ctx, params)
+	if m.SetMetacounterWithContextFunc != nil {
+		return m.SetMetacounterWithContextFunc(ctx, params)
+	}
+	if m.SetMetacounterFunc != nil {
+		return m.SetMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// IncreaseMetacounter records the call and calls IncreaseMetacounterFunc.
+func (m *ChatChannelService) IncreaseMetacounter(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("IncreaseMetacounter", nil, params)
+	if m.IncreaseMetacounterFunc != nil {
+		return m.IncreaseMetacounterFunc(params)
+	}
+	if m.IncreaseMetacounterWithContextFunc != nil {
+		return m.IncreaseMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// IncreaseMetacounterWithContext records the call and calls IncreaseMetacounterWithContextFunc.
+func (m *ChatChannelService) IncreaseMetacounterWithContext(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("IncreaseMetacounterWithContext", ctx, params)
+	if m.IncreaseMetacounterWithContextFunc != nil {
+		return m.IncreaseMetacounterWithContextFunc(ctx, params)
+	}
+	if m.IncreaseMetacounterFunc != nil {
+		return m.IncreaseMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DecreaseMetacounter records the call and calls DecreaseMetacounterFunc.
+func (m *ChatChannelService) DecreaseMetacounter(params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("DecreaseMetacounter", nil, params)
+	if m.DecreaseMetacounterFunc != nil {
+		return m.DecreaseMetacounterFunc(params)
+	}
+	if m.DecreaseMetacounterWithContextFunc != nil {
+		return m.DecreaseMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DecreaseMetacounterWithContext records the call and calls DecreaseMetacounterWithContextFunc.
+func (m *ChatChannelService) DecreaseMetacounterWithContext(ctx context.Context, params *sendbird.ChatChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("DecreaseMetacounterWithContext", ctx, params)
+	if m.DecreaseMetacounterWithContextFunc != nil {
+		return m.DecreaseMetacounterWithContextFunc(ctx, params)
+	}
+	if m.DecreaseMetacounterFunc != nil {
+		return m.DecreaseMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MessageCount records the call and calls MessageCountFunc.
+func (m *ChatChannelService) MessageCount(channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error) {
+	m.record("MessageCount", nil, channelUrl)
+	if m.MessageCountFunc != nil {
+		return m.MessageCountFunc(channelUrl)
+	}
+	if m.MessageCountWithContextFunc != nil {
+		return m.MessageCountWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.MessageCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MessageCountWithContext records the call and calls MessageCountWithContextFunc.
+func (m *ChatChannelService) MessageCountWithContext(ctx context.Context, channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error) {
+	m.record("MessageCountWithContext", ctx, channelUrl)
+	if m.MessageCountWithContextFunc != nil {
+		return m.MessageCountWithContextFunc(ctx, channelUrl)
+	}
+	if m.MessageCountFunc != nil {
+		return m.MessageCountFunc(channelUrl)
+	}
+	var r0 *sendbird.MessageCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GroupChannelService is a mock of sendbird.GroupChannelService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type GroupChannelService struct {
+	Recorder
+
+	CreateFunc                       func(params *sendbird.GroupChannelCreateRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	CreateWithContextFunc            func(ctx context.Context, params *sendbird.GroupChannelCreateRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	ListFunc                         func(opts *sendbird.GroupChannelListOptions) (*sendbird.GroupChannelList, *sendbird.Response, error)
+	ListWithContextFunc              func(ctx context.Context, opts *sendbird.GroupChannelListOptions) (*sendbird.GroupChannelList, *sendbird.Response, error)
+	GetFunc                          func(channelUrl string) (*sendbird.GroupChannel, *sendbird.Response, error)
+	GetWithContextFunc               func(ctx context.Context, channelUrl string) (*sendbird.GroupChannel, *sendbird.Response, error)
+	UpdateFunc                       func(channelUrl string, params *sendbird.GroupChannelUpdateRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	UpdateWithContextFunc            func(ctx context.Context, channelUrl string, params *sendbird.GroupChannelUpdateRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	DeleteFunc                       func(channelUrl string) (*sendbird.Response, error)
+	DeleteWithContextFunc            func(ctx context.Context, channelUrl string) (*sendbird.Response, error)
+	InviteFunc                       func(channelUrl string, params *sendbird.GroupChannelInviteRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	InviteWithContextFunc            func(ctx context.Context, channelUrl string, params *sendbird.GroupChannelInviteRequest) (*sendbird.GroupChannel, *sendbird.Response, error)
+	LeaveFunc                        func(channelUrl string, userIds []string) (*sendbird.Response, error)
+	LeaveWithContextFunc             func(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error)
+	HideFunc                         func(channelUrl string, params *sendbird.GroupChannelHideRequest) (*sendbird.Response, error)
+	HideWithContextFunc              func(ctx context.Context, channelUrl string, params *sendbird.GroupChannelHideRequest) (*sendbird.Response, error)
+	UnhideFunc                       func(channelUrl string, userId string) (*sendbird.Response, error)
+	UnhideWithContextFunc            func(ctx context.Context, channelUrl string, userId string) (*sendbird.Response, error)
+	AcceptInvitationFunc             func(channelUrl string, userId string) (*sendbird.GroupChannel, *sendbird.Response, error)
+	AcceptInvitationWithContextFunc  func(ctx context.Context, channelUrl string, userId string) (*sendbird.GroupChannel, *sendbird.Response, error)
+	DeclineInvitationFunc            func(channelUrl string, userId string) (*sendbird.Response, error)
+	DeclineInvitationWithContextFunc func(ctx context.Context, channelUrl string, userId string) (*sendbird.Response, error)
+	ResetHistoryFunc                 func(channelUrl string, params *sendbird.GroupChannelResetHistoryRequest) (*sendbird.Response, error)
+	ResetHistoryWithContextFunc      func(ctx context.Context, channelUrl string, params *sendbird.GroupChannelResetHistoryRequest) (*sendbird.Response, error)
+}
+
+var _ sendbird.GroupChannelService = &GroupChannelService{}
+
+// Create records the call and calls CreateFunc.
+func (m *GroupChannelService) Create(params *sendbird.GroupChannelCreateRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("Create", nil, params)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *GroupChannelService) CreateWithContext(ctx context.Context, params *sendbird.GroupChannelCreateRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *GroupChannelService) List(opts *sendbird.GroupChannelListOptions) (*sendbird.GroupChannelList, *sendbird.Response, error) {
+	m.record("List", nil, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background(), opts)
+	}
+	var r0 *sendbird.GroupChannelList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *GroupChannelService) ListWithContext(ctx context.Context, opts *sendbird.GroupChannelListOptions) (*sendbird.GroupChannelList, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx, opts)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx, opts)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	var r0 *sendbird.GroupChannelList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Get records the call and calls GetFunc.
+func (m *GroupChannelService) Get(channelUrl string) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("Get", nil, channelUrl)
+	if m.GetFunc != nil {
+		return m.GetFunc(channelUrl)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetWithContext records the call and calls GetWithContextFunc.
+func (m *GroupChannelService) GetWithContext(ctx context.Context, channelUrl string) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("GetWithContext", ctx, channelUrl)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, channelUrl)
+	}
+	if m.GetFunc != nil {
+		return m.GetFunc(channelUrl)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *GroupChannelService) Update(channelUrl string, params *sendbird.GroupChannelUpdateRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("Update", nil, channelUrl, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelUrl, params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), channelUrl, params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *GroupChannelService) UpdateWithContext(ctx context.Context, channelUrl string, params *sendbird.GroupChannelUpdateRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, channelUrl, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, channelUrl, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelUrl, params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *GroupChannelService) Delete(channelUrl string) (*sendbird.Response, error) {
+	m.record("Delete", nil, channelUrl)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *GroupChannelService) DeleteWithContext(ctx context.Context, channelUrl string) (*sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, channelUrl)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, channelUrl)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Invite records the call and calls InviteFunc.
+func (m *GroupChannelService) Invite(channelUrl string, params *sendbird.GroupChannelInviteRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("Invite", nil, channelUrl, params)
+	if m.InviteFunc != nil {
+		return m.InviteFunc(channelUrl, params)
+	}
+	if m.InviteWithContextFunc != nil {
+		return m.InviteWithContextFunc(context.Background(), channelUrl, params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InviteWithContext records the call and calls InviteWithContextFunc.
+func (m *GroupChannelService) InviteWithContext(ctx context.Context, channelUrl string, params *sendbird.GroupChannelInviteRequest) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("InviteWithContext", ctx, channelUrl, params)
+	if m.InviteWithContextFunc != nil {
+		return m.InviteWithContextFunc(ctx, channelUrl, params)
+	}
+	if m.InviteFunc != nil {
+		return m.InviteFunc(channelUrl, params)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Leave records the call and calls LeaveFunc.
+func (m *GroupChannelService) Leave(channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("Leave", nil, channelUrl, userIds)
+	if m.LeaveFunc != nil {
+		return m.LeaveFunc(channelUrl, userIds)
+	}
+	if m.LeaveWithContextFunc != nil {
+		return m.LeaveWithContextFunc(context.Background(), channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// LeaveWithContext records the call and calls LeaveWithContextFunc.
+func (m *GroupChannelService) LeaveWithContext(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("LeaveWithContext", ctx, channelUrl, userIds)
+	if m.LeaveWithContextFunc != nil {
+		return m.LeaveWithContextFunc(ctx, channelUrl, userIds)
+	}
+	if m.LeaveFunc != nil {
+		return m.LeaveFunc(channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Hide records the call and calls HideFunc.
+func (m *GroupChannelService) Hide(channelUrl string, params *sendbird.GroupChannelHideRequest) (*sendbird.Response, error) {
+	m.record("Hide", nil, channelUrl, params)
+	if m.HideFunc != nil {
+		return m.HideFunc(channelUrl, params)
+	}
+	if m.HideWithContextFunc != nil {
+		return m.HideWithContextFunc(context.Background(), channelUrl, params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// HideWithContext records the call and calls HideWithContextFunc.
+func (m *GroupChannelService) HideWithContext(ctx context.Context, channelUrl string, params *sendbird.GroupChannelHideRequest) (*sendbird.Response, error) {
+	m.record("HideWithContext", ctx, channelUrl, params)
+	if m.HideWithContextFunc != nil {
+		return m.HideWithContextFunc(ctx, channelUrl, params)
+	}
+	if m.HideFunc != nil {
+		return m.HideFunc(channelUrl, params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Unhide records the call and calls UnhideFunc.
+func (m *GroupChannelService) Unhide(channelUrl string, userId string) (*sendbird.Response, error) {
+	m.record("Unhide", nil, channelUrl, userId)
+	if m.UnhideFunc != nil {
+		return m.UnhideFunc(channelUrl, userId)
+	}
+	if m.UnhideWithContextFunc != nil {
+		return m.UnhideWithContextFunc(context.Background(), channelUrl, userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UnhideWithContext records the call and calls UnhideWithContextFunc.
+func (m *GroupChannelService) UnhideWithContext(ctx context.Context, channelUrl string, userId string) (*sendbird.Response, error) {
+	m.record("UnhideWithContext", ctx, channelUrl, userId)
+	if m.UnhideWithContextFunc != nil {
+		return m.UnhideWithContextFunc(ctx, channelUrl, userId)
+	}
+	if m.UnhideFunc != nil {
+		return m.UnhideFunc(channelUrl, userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// AcceptInvitation records the call and calls AcceptInvitationFunc.
+func (m *GroupChannelService) AcceptInvitation(channelUrl string, userId string) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("AcceptInvitation", nil, channelUrl, userId)
+	if m.AcceptInvitationFunc != nil {
+		return m.AcceptInvitationFunc(channelUrl, userId)
+	}
+	if m.AcceptInvitationWithContextFunc != nil {
+		return m.AcceptInvitationWithContextFunc(context.Background(), channelUrl, userId)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AcceptInvitationWithContext records the call and calls AcceptInvitationWithContextFunc.
+func (m *GroupChannelService) AcceptInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*sendbird.GroupChannel, *sendbird.Response, error) {
+	m.record("AcceptInvitationWithContext", ctx, channelUrl, userId)
+	if m.AcceptInvitationWithContextFunc != nil {
+		return m.AcceptInvitationWithContextFunc(ctx, channelUrl, userId)
+	}
+	if m.AcceptInvitationFunc != nil {
+		return m.AcceptInvitationFunc(channelUrl, userId)
+	}
+	var r0 *sendbird.GroupChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeclineInvitation records the call and calls DeclineInvitationFunc.
+func (m *GroupChannelService) DeclineInvitation(channelUrl string, userId string) (*sendbird.Response, error) {
+	m.record("DeclineInvitation", nil, channelUrl, userId)
+	if m.DeclineInvitationFunc != nil {
+		return m.DeclineInvitationFunc(channelUrl, userId)
+	}
+	if m.DeclineInvitationWithContextFunc != nil {
+		return m.DeclineInvitationWithContextFunc(context.Background(), channelUrl, userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeclineInvitationWithContext records the call and calls DeclineInvitationWithContextFunc.
+func (m *GroupChannelService) DeclineInvitationWithContext(ctx context.Context, channelUrl string, userId string) (*sendbird.Response, error) {
+	m.record("DeclineInvitationWithContext", ctx, channelUrl, userId)
+	if m.DeclineInvitationWithContextFunc != nil {
+		return m.DeclineInvitationWithContextFunc(ctx, channelUrl, userId)
+	}
+	if m.DeclineInvitationFunc != nil {
+		return m.DeclineInvitationFunc(channelUrl, userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// ResetHistory records the call and calls ResetHistoryFunc.
+func (m *GroupChannelService) ResetHistory(channelUrl string, params *sendbird.GroupChannelResetHistoryRequest) (*sendbird.Response, error) {
+	m.record("ResetHistory", nil, channelUrl, params)
+	if m.ResetHistoryFunc != nil {
+		return m.ResetHistoryFunc(channelUrl, params)
+	}
+	if m.ResetHistoryWithContextFunc != nil {
+		return m.ResetHistoryWithContextFunc(context.Background(), channelUrl, params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// ResetHistoryWithContext records the call and calls ResetHistoryWithContextFunc.
+func (m *GroupChannelService) ResetHistoryWithContext(ctx context.Context, channelUrl string, params *sendbird.GroupChannelResetHistoryRequest) (*sendbird.Response, error) {
+	m.record("ResetHistoryWithContext", ctx, channelUrl, params)
+	if m.ResetHistoryWithContextFunc != nil {
+		return m.ResetHistoryWithContextFunc(ctx, channelUrl, params)
+	}
+	if m.ResetHistoryFunc != nil {
+		return m.ResetHistoryFunc(channelUrl, params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// MessageService is a mock of sendbird.MessageService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type MessageService struct {
+	Recorder
+
+	SendFunc              func(channelType sendbird.ChannelType, channelUrl string, params *sendbird.MessageSendRequest) (*sendbird.Message, *sendbird.Response, error)
+	SendWithContextFunc   func(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, params *sendbird.MessageSendRequest) (*sendbird.Message, *sendbird.Response, error)
+	ListFunc              func(channelType sendbird.ChannelType, channelUrl string, opts *sendbird.MessageListOptions) ([]sendbird.Message, *sendbird.Response, error)
+	ListWithContextFunc   func(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, opts *sendbird.MessageListOptions) ([]sendbird.Message, *sendbird.Response, error)
+	GetFunc               func(channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Message, *sendbird.Response, error)
+	GetWithContextFunc    func(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Message, *sendbird.Response, error)
+	UpdateFunc            func(channelType sendbird.ChannelType, channelUrl string, messageId int64, params *sendbird.MessageUpdateRequest) (*sendbird.Message, *sendbird.Response, error)
+	UpdateWithContextFunc func(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64, params *sendbird.MessageUpdateRequest) (*sendbird.Message, *sendbird.Response, error)
+	DeleteFunc            func(channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Response, error)
+	DeleteWithContextFunc func(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Response, error)
+}
+
+var _ sendbird.MessageService = &MessageService{}
+
+// Send records the call and calls SendFunc.
+func (m *MessageService) Send(channelType sendbird.ChannelType, channelUrl string, params *sendbird.MessageSendRequest) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("Send", nil, channelType, channelUrl, params)
+	if m.SendFunc != nil {
+		return m.SendFunc(channelType, channelUrl, params)
+	}
+	if m.SendWithContextFunc != nil {
+		return m.SendWithContextFunc(context.Background(), channelType, channelUrl, params)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SendWithContext records the call and calls SendWithContextFunc.
+func (m *MessageService) SendWithContext(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, params *sendbird.MessageSendRequest) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("SendWithContext", ctx, channelType, channelUrl, params)
+	if m.SendWithContextFunc != nil {
+		return m.SendWithContextFunc(ctx, channelType, channelUrl, params)
+	}
+	if m.SendFunc != nil {
+		return m.SendFunc(channelType, channelUrl, params)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *MessageService) List(channelType sendbird.ChannelType, channelUrl string, opts *sendbird.MessageListOptions) ([]sendbird.Message, *sendbird.Response, error) {
+	m.record("List", nil, channelType, channelUrl, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(channelType, channelUrl, opts)
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background(), channelType, channelUrl, opts)
+	}
+	var r0 []sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *MessageService) ListWithContext(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, opts *sendbird.MessageListOptions) ([]sendbird.Message, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx, channelType, channelUrl, opts)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx, channelType, channelUrl, opts)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc(channelType, channelUrl, opts)
+	}
+	var r0 []sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Get records the call and calls GetFunc.
+func (m *MessageService) Get(channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("Get", nil, channelType, channelUrl, messageId)
+	if m.GetFunc != nil {
+		return m.GetFunc(channelType, channelUrl, messageId)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), channelType, channelUrl, messageId)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetWithContext records the call and calls GetWithContextFunc.
+func (m *MessageService) GetWithContext(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("GetWithContext", ctx, channelType, channelUrl, messageId)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, channelType, channelUrl, messageId)
+	}
+	if m.GetFunc != nil {
+		return m.GetFunc(channelType, channelUrl, messageId)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *MessageService) Update(channelType sendbird.ChannelType, channelUrl string, messageId int64, params *sendbird.MessageUpdateRequest) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("Update", nil, channelType, channelUrl, messageId, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelType, channelUrl, messageId, params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), channelType, channelUrl, messageId, params)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *MessageService) UpdateWithContext(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64, params *sendbird.MessageUpdateRequest) (*sendbird.Message, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, channelType, channelUrl, messageId, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, channelType, channelUrl, messageId, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelType, channelUrl, messageId, params)
+	}
+	var r0 *sendbird.Message
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *MessageService) Delete(channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Response, error) {
+	m.record("Delete", nil, channelType, channelUrl, messageId)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelType, channelUrl, messageId)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), channelType, channelUrl, messageId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *MessageService) DeleteWithContext(ctx context.Context, channelType sendbird.ChannelType, channelUrl string, messageId int64) (*sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, channelType, channelUrl, messageId)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, channelType, channelUrl, messageId)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelType, channelUrl, messageId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// MessagingChannelService is a mock of sendbird.MessagingChannelService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type MessagingChannelService struct {
+	Recorder
+
+	CreateFunc                         func(arg0 *sendbird.MessagingChannelRequest) (*sendbird.MessagingChannel, *sendbird.Response, error)
+	CreateWithContextFunc              func(ctx context.Context, params *sendbird.MessagingChannelRequest) (*sendbird.MessagingChannel, *sendbird.Response, error)
+	UpdateFunc                         func(params *sendbird.MessagingChannelUpdateRequest) (*sendbird.MessagingChannel, *sendbird.Response, error)
+	UpdateWithContextFunc              func(ctx context.Context, params *sendbird.MessagingChannelUpdateRequest) (*sendbird.MessagingChannel, *sendbird.Response, error)
+	DeleteFunc                         func(channelUrl string) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	DeleteWithContextFunc              func(ctx context.Context, channelUrl string) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	InviteFunc                         func(params *sendbird.MessagingChannelInviteRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	InviteWithContextFunc              func(ctx context.Context, params *sendbird.MessagingChannelInviteRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	HideFunc                           func(params *sendbird.MessagingChannelHideRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	HideWithContextFunc                func(ctx context.Context, params *sendbird.MessagingChannelHideRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	LeaveFunc                          func(params *sendbird.MessagingChannelLeaveRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	LeaveWithContextFunc               func(ctx context.Context, params *sendbird.MessagingChannelLeaveRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error)
+	ViewFunc                           func(channelUrl string) (*sendbird.MessagingChannelView, *sendbird.Response, error)
+	ViewWithContextFunc                func(ctx context.Context, channelUrl string) (*sendbird.MessagingChannelView, *sendbird.Response, error)
+	GetMetadataFunc                    func(params *sendbird.MessagingChannelMetadataRequest) (map[string]string, *sendbird.Response, error)
+	GetMetadataWithContextFunc         func(ctx context.Context, params *sendbird.MessagingChannelMetadataRequest) (map[string]string, *sendbird.Response, error)
+	SetMetadataFunc                    func(params *sendbird.MessagingChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error)
+	SetMetadataWithContextFunc         func(ctx context.Context, params *sendbird.MessagingChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error)
+	GetMetacounterFunc                 func(params *sendbird.MessagingChannelMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	GetMetacounterWithContextFunc      func(ctx context.Context, params *sendbird.MessagingChannelMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	SetMetacounterFunc                 func(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	SetMetacounterWithContextFunc      func(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	IncreaseMetacounterFunc            func(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	IncreaseMetacounterWithContextFunc func(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	DecreaseMetacounterFunc            func(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	DecreaseMetacounterWithContextFunc func(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error)
+	MessageCountFunc                   func(channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error)
+	MessageCountWithContextFunc        func(ctx context.Context, channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error)
+}
+
+var _ sendbird.MessagingChannelService = &MessagingChannelService{}
+
+// Create records the call and calls CreateFunc.
+func (m *MessagingChannelService) Create(arg0 *sendbird.MessagingChannelRequest) (*sendbird.MessagingChannel, *sendbird.Response, error) {
+	m.record("Create", nil, arg0)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), arg0)
+	}
+	var r0 *sendbird.MessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *MessagingChannelService) CreateWithContext(ctx context.Context, params *sendbird.MessagingChannelRequest) (*sendbird.MessagingChannel, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.MessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *MessagingChannelService) Update(params *sendbird.MessagingChannelUpdateRequest) (*sendbird.MessagingChannel, *sendbird.Response, error) {
+	m.record("Update", nil, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.MessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *MessagingChannelService) UpdateWithContext(ctx context.Context, params *sendbird.MessagingChannelUpdateRequest) (*sendbird.MessagingChannel, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	var r0 *sendbird.MessagingChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *MessagingChannelService) Delete(channelUrl string) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("Delete", nil, channelUrl)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *MessagingChannelService) DeleteWithContext(ctx context.Context, channelUrl string) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, channelUrl)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, channelUrl)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Invite records the call and calls InviteFunc.
+func (m *MessagingChannelService) Invite(params *sendbird.MessagingChannelInviteRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("Invite", nil, params)
+	if m.InviteFunc != nil {
+		return m.InviteFunc(params)
+	}
+	if m.InviteWithContextFunc != nil {
+		return m.InviteWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// InviteWithContext records the call and calls InviteWithContextFunc.
+func (m *MessagingChannelService) InviteWithContext(ctx context.Context, params *sendbird.MessagingChannelInviteRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("InviteWithContext", ctx, params)
+	if m.InviteWithContextFunc != nil {
+		return m.InviteWithContextFunc(ctx, params)
+	}
+	if m.InviteFunc != nil {
+		return m.InviteFunc(params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Hide records the call and calls HideFunc.
+func (m *MessagingChannelService) Hide(params *sendbird.MessagingChannelHideRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("Hide", nil, params)
+	if m.HideFunc != nil {
+		return m.HideFunc(params)
+	}
+	if m.HideWithContextFunc != nil {
+		return m.HideWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// HideWithContext records the call and calls HideWithContextFunc.
+func (m *MessagingChannelService) HideWithContext(ctx context.Context, params *sendbird.MessagingChannelHideRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("HideWithContext", ctx, params)
+	if m.HideWithContextFunc != nil {
+		return m.HideWithContextFunc(ctx, params)
+	}
+	if m.HideFunc != nil {
+		return m.HideFunc(params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Leave records the call and calls LeaveFunc.
+func (m *MessagingChannelService) Leave(params *sendbird.MessagingChannelLeaveRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("Leave", nil, params)
+	if m.LeaveFunc != nil {
+		return m.LeaveFunc(params)
+	}
+	if m.LeaveWithContextFunc != nil {
+		return m.LeaveWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// LeaveWithContext records the call and calls LeaveWithContextFunc.
+func (m *MessagingChannelService) LeaveWithContext(ctx context.Context, params *sendbird.MessagingChannelLeaveRequest) (*sendbird.MessagingChannelUrl, *sendbird.Response, error) {
+	m.record("LeaveWithContext", ctx, params)
+	if m.LeaveWithContextFunc != nil {
+		return m.LeaveWithContextFunc(ctx, params)
+	}
+	if m.LeaveFunc != nil {
+		return m.LeaveFunc(params)
+	}
+	var r0 *sendbird.MessagingChannelUrl
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// View records the call and calls ViewFunc.
+func (m *MessagingChannelService) View(channelUrl string) (*sendbird.MessagingChannelView, *sendbird.Response, error) {
+	m.record("View", nil, channelUrl)
+	if m.ViewFunc != nil {
+		return m.ViewFunc(channelUrl)
+	}
+	if m.ViewWithContextFunc != nil {
+		return m.ViewWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.MessagingChannelView
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ViewWithContext records the call and calls ViewWithContextFunc.
+func (m *MessagingChannelService) ViewWithContext(ctx context.Context, channelUrl string) (*sendbird.MessagingChannelView, *sendbird.Response, error) {
+	m.record("ViewWithContext", ctx, channelUrl)
+	if m.ViewWithContextFunc != nil {
+		return m.ViewWithContextFunc(ctx, channelUrl)
+	}
+	if m.ViewFunc != nil {
+		return m.ViewFunc(channelUrl)
+	}
+	var r0 *sendbird.MessagingChannelView
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetadata records the call and calls GetMetadataFunc.
+func (m *MessagingChannelService) GetMetadata(params *sendbird.MessagingChannelMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("GetMetadata", nil, params)
+	if m.GetMetadataFunc != nil {
+		return m.GetMetadataFunc(params)
+	}
+	if m.GetMetadataWithContextFunc != nil {
+		return m.GetMetadataWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetadataWithContext records the call and calls GetMetadataWithContextFunc.
+func (m *MessagingChannelService) GetMetadataWithContext(ctx context.Context, params *sendbird.MessagingChannelMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("GetMetadataWithContext", ctx, params)
+	if m.GetMetadataWithContextFunc != nil {
+		return m.GetMetadataWithContextFunc(ctx, params)
+	}
+	if m.GetMetadataFunc != nil {
+		return m.GetMetadataFunc(params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetadata records the call and calls SetMetadataFunc.
+func (m *MessagingChannelService) SetMetadata(params *sendbird.MessagingChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("SetMetadata", nil, params)
+	if m.SetMetadataFunc != nil {
+		return m.SetMetadataFunc(params)
+	}
+	if m.SetMetadataWithContextFunc != nil {
+		return m.SetMetadataWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetadataWithContext records the call and calls SetMetadataWithContextFunc.
+func (m *MessagingChannelService) SetMetadataWithContext(ctx context.Context, params *sendbird.MessagingChannelSetMetadataRequest) (map[string]string, *sendbird.Response, error) {
+	m.record("SetMetadataWithContext", ctx, params)
+	if m.SetMetadataWithContextFunc != nil {
+		return m.SetMetadataWithContextFunc(ctx, params)
+	}
+	if m.SetMetadataFunc != nil {
+		return m.SetMetadataFunc(params)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetacounter records the call and calls GetMetacounterFunc.
+func (m *MessagingChannelService) GetMetacounter(params *sendbird.MessagingChannelMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("GetMetacounter", nil, params)
+	if m.GetMetacounterFunc != nil {
+		return m.GetMetacounterFunc(params)
+	}
+	if m.GetMetacounterWithContextFunc != nil {
+		return m.GetMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetMetacounterWithContext records the call and calls GetMetacounterWithContextFunc.
+func (m *MessagingChannelService) GetMetacounterWithContext(ctx context.Context, params *sendbird.MessagingChannelMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("GetMetacounterWithContext", ctx, params)
+	if m.GetMetacounterWithContextFunc != nil {
+		return m.GetMetacounterWithContextFunc(ctx, params)
+	}
+	if m.GetMetacounterFunc != nil {
+		return m.GetMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetacounter records the call and calls SetMetacounterFunc.
+func (m *MessagingChannelService) SetMetacounter(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("SetMetacounter", nil, params)
+	if m.SetMetacounterFunc != nil {
+		return m.SetMetacounterFunc(params)
+	}
+	if m.SetMetacounterWithContextFunc != nil {
+		return m.SetMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// SetMetacounterWithContext records the call and calls SetMetacounterWithContextFunc.
+func (m *MessagingChannelService) SetMetacounterWithContext(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("SetMetacounterWithContext", ctx, params)
+	if m.SetMetacounterWithContextFunc != nil {
+		return m.SetMetacounterWithContextFunc(ctx, params)
+	}
+	if m.SetMetacounterFunc != nil {
+		return m.SetMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// IncreaseMetacounter records the call and calls IncreaseMetacounterFunc.
+func (m *MessagingChannelService) IncreaseMetacounter(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("IncreaseMetacounter", nil, params)
+	if m.IncreaseMetacounterFunc != nil {
+		return m.IncreaseMetacounterFunc(params)
+	}
+	if m.IncreaseMetacounterWithContextFunc != nil {
+		return m.IncreaseMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// IncreaseMetacounterWithContext records the call and calls IncreaseMetacounterWithContextFunc.
+func (m *MessagingChannelService) IncreaseMetacounterWithContext(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("IncreaseMetacounterWithContext", ctx, params)
+	if m.IncreaseMetacounterWithContextFunc != nil {
+		return m.IncreaseMetacounterWithContextFunc(ctx, params)
+	}
+	if m.IncreaseMetacounterFunc != nil {
+		return m.IncreaseMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DecreaseMetacounter records the call and calls DecreaseMetacounterFunc.
+func (m *MessagingChannelService) DecreaseMetacounter(params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("DecreaseMetacounter", nil, params)
+	if m.DecreaseMetacounterFunc != nil {
+		return m.DecreaseMetacounterFunc(params)
+	}
+	if m.DecreaseMetacounterWithContextFunc != nil {
+		return m.DecreaseMetacounterWithContextFunc(context.Background(), params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DecreaseMetacounterWithContext records the call and calls DecreaseMetacounterWithContextFunc.
+func (m *MessagingChannelService) DecreaseMetacounterWithContext(ctx context.Context, params *sendbird.MessagingChannelSetMetacounterRequest) (map[string]int, *sendbird.Response, error) {
+	m.record("DecreaseMetacounterWithContext", ctx, params)
+	if m.DecreaseMetacounterWithContextFunc != nil {
+		return m.DecreaseMetacounterWithContextFunc(ctx, params)
+	}
+	if m.DecreaseMetacounterFunc != nil {
+		return m.DecreaseMetacounterFunc(params)
+	}
+	var r0 map[string]int
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MessageCount records the call and calls MessageCountFunc.
+func (m *MessagingChannelService) MessageCount(channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error) {
+	m.record("MessageCount", nil, channelUrl)
+	if m.MessageCountFunc != nil {
+		return m.MessageCountFunc(channelUrl)
+	}
+	if m.MessageCountWithContextFunc != nil {
+		return m.MessageCountWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.MessageCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MessageCountWithContext records the call and calls MessageCountWithContextFunc.
+func (m *MessagingChannelService) MessageCountWithContext(ctx context.Context, channelUrl string) (*sendbird.MessageCount, *sendbird.Response, error) {
+	m.record("MessageCountWithContext", ctx, channelUrl)
+	if m.MessageCountWithContextFunc != nil {
+		return m.MessageCountWithContextFunc(ctx, channelUrl)
+	}
+	if m.MessageCountFunc != nil {
+		return m.MessageCountFunc(channelUrl)
+	}
+	var r0 *sendbird.MessageCount
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// OpenChannelService is a mock of sendbird.OpenChannelService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type OpenChannelService struct {
+	Recorder
+
+	CreateFunc                       func(params *sendbird.OpenChannelCreateRequest) (*sendbird.OpenChannel, *sendbird.Response, error)
+	CreateWithContextFunc            func(ctx context.Context, params *sendbird.OpenChannelCreateRequest) (*sendbird.OpenChannel, *sendbird.Response, error)
+	ListFunc                         func(opts *sendbird.OpenChannelListOptions) (*sendbird.OpenChannelList, *sendbird.Response, error)
+	ListWithContextFunc              func(ctx context.Context, opts *sendbird.OpenChannelListOptions) (*sendbird.OpenChannelList, *sendbird.Response, error)
+	GetFunc                          func(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	GetWithContextFunc               func(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	UpdateFunc                       func(channelUrl string, params *sendbird.OpenChannelUpdateRequest) (*sendbird.OpenChannel, *sendbird.Response, error)
+	UpdateWithContextFunc            func(ctx context.Context, channelUrl string, params *sendbird.OpenChannelUpdateRequest) (*sendbird.OpenChannel, *sendbird.Response, error)
+	DeleteFunc                       func(channelUrl string) (*sendbird.Response, error)
+	DeleteWithContextFunc            func(ctx context.Context, channelUrl string) (*sendbird.Response, error)
+	ParticipantsFunc                 func(channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error)
+	ParticipantsWithContextFunc      func(ctx context.Context, channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error)
+	OperatorsFunc                    func(channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error)
+	OperatorsWithContextFunc         func(ctx context.Context, channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error)
+	AddOperatorsFunc                 func(channelUrl string, userIds []string) (*sendbird.Response, error)
+	AddOperatorsWithContextFunc      func(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error)
+	RemoveOperatorsFunc              func(channelUrl string, userIds []string) (*sendbird.Response, error)
+	RemoveOperatorsWithContextFunc   func(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error)
+	FreezeFunc                       func(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	FreezeWithContextFunc            func(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	UnfreezeFunc                     func(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	UnfreezeWithContextFunc          func(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error)
+	MetadataFunc                     func(channelUrl string) (map[string]string, *sendbird.Response, error)
+	MetadataWithContextFunc          func(ctx context.Context, channelUrl string) (map[string]string, *sendbird.Response, error)
+	CreateMetadataFunc               func(channelUrl string, metadata map[string]string) (map[string]string, *sendbird.Response, error)
+	CreateMetadataWithContextFunc    func(ctx context.Context, channelUrl string, metadata map[string]string) (map[string]string, *sendbird.Response, error)
+	UpdateMetadataFunc               func(channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error)
+	UpdateMetadataWithContextFunc    func(ctx context.Context, channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error)
+	DeleteMetadataFunc               func(channelUrl string, key string) (*sendbird.Response, error)
+	DeleteMetadataWithContextFunc    func(ctx context.Context, channelUrl string, key string) (*sendbird.Response, error)
+	DeleteAllMetadataFunc            func(channelUrl string) (*sendbird.Response, error)
+	DeleteAllMetadataWithContextFunc func(ctx context.Context, channelUrl string) (*sendbird.Response, error)
+}
+
+var _ sendbird.OpenChannelService = &OpenChannelService{}
+
+// Create records the call and calls CreateFunc.
+func (m *OpenChannelService) Create(params *sendbird.OpenChannelCreateRequest) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("Create", nil, params)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *OpenChannelService) CreateWithContext(ctx context.Context, params *sendbird.OpenChannelCreateRequest) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *OpenChannelService) List(opts *sendbird.OpenChannelListOptions) (*sendbird.OpenChannelList, *sendbird.Response, error) {
+	m.record("List", nil, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background(), opts)
+	}
+	var r0 *sendbird.OpenChannelList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *OpenChannelService) ListWithContext(ctx context.Context, opts *sendbird.OpenChannelListOptions) (*sendbird.OpenChannelList, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx, opts)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx, opts)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	var r0 *sendbird.OpenChannelList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Get records the call and calls GetFunc.
+func (m *OpenChannelService) Get(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("Get", nil, channelUrl)
+	if m.GetFunc != nil {
+		return m.GetFunc(channelUrl)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetWithContext records the call and calls GetWithContextFunc.
+func (m *OpenChannelService) GetWithContext(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("GetWithContext", ctx, channelUrl)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, channelUrl)
+	}
+	if m.GetFunc != nil {
+		return m.GetFunc(channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *OpenChannelService) Update(channelUrl string, params *sendbird.OpenChannelUpdateRequest) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("Update", nil, channelUrl, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelUrl, params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), channelUrl, params)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *OpenChannelService) UpdateWithContext(ctx context.Context, channelUrl string, params *sendbird.OpenChannelUpdateRequest) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, channelUrl, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, channelUrl, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(channelUrl, params)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *OpenChannelService) Delete(channelUrl string) (*sendbird.Response, error) {
+	m.record("Delete", nil, channelUrl)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *OpenChannelService) DeleteWithContext(ctx context.Context, channelUrl string) (*sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, channelUrl)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, channelUrl)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Participants records the call and calls ParticipantsFunc.
+func (m *OpenChannelService) Participants(channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error) {
+	m.record("Participants", nil, channelUrl, token, limit)
+	if m.ParticipantsFunc != nil {
+		return m.ParticipantsFunc(channelUrl, token, limit)
+	}
+	if m.ParticipantsWithContextFunc != nil {
+		return m.ParticipantsWithContextFunc(context.Background(), channelUrl, token, limit)
+	}
+	var r0 *sendbird.OpenChannelUserList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ParticipantsWithContext records the call and calls ParticipantsWithContextFunc.
+func (m *OpenChannelService) ParticipantsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error) {
+	m.record("ParticipantsWithContext", ctx, channelUrl, token, limit)
+	if m.ParticipantsWithContextFunc != nil {
+		return m.ParticipantsWithContextFunc(ctx, channelUrl, token, limit)
+	}
+	if m.ParticipantsFunc != nil {
+		return m.ParticipantsFunc(channelUrl, token, limit)
+	}
+	var r0 *sendbird.OpenChannelUserList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Operators records the call and calls OperatorsFunc.
+func (m *OpenChannelService) Operators(channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error) {
+	m.record("Operators", nil, channelUrl, token, limit)
+	if m.OperatorsFunc != nil {
+		return m.OperatorsFunc(channelUrl, token, limit)
+	}
+	if m.OperatorsWithContextFunc != nil {
+		return m.OperatorsWithContextFunc(context.Background(), channelUrl, token, limit)
+	}
+	var r0 *sendbird.OpenChannelUserList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// OperatorsWithContext records the call and calls OperatorsWithContextFunc.
+func (m *OpenChannelService) OperatorsWithContext(ctx context.Context, channelUrl string, token string, limit int) (*sendbird.OpenChannelUserList, *sendbird.Response, error) {
+	m.record("OperatorsWithContext", ctx, channelUrl, token, limit)
+	if m.OperatorsWithContextFunc != nil {
+		return m.OperatorsWithContextFunc(ctx, channelUrl, token, limit)
+	}
+	if m.OperatorsFunc != nil {
+		return m.OperatorsFunc(channelUrl, token, limit)
+	}
+	var r0 *sendbird.OpenChannelUserList
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AddOperators records the call and calls AddOperatorsFunc.
+func (m *OpenChannelService) AddOperators(channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("AddOperators", nil, channelUrl, userIds)
+	if m.AddOperatorsFunc != nil {
+		return m.AddOperatorsFunc(channelUrl, userIds)
+	}
+	if m.AddOperatorsWithContextFunc != nil {
+		return m.AddOperatorsWithContextFunc(context.Background(), channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// AddOperatorsWithContext records the call and calls AddOperatorsWithContextFunc.
+func (m *OpenChannelService) AddOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("AddOperatorsWithContext", ctx, channelUrl, userIds)
+	if m.AddOperatorsWithContextFunc != nil {
+		return m.AddOperatorsWithContextFunc(ctx, channelUrl, userIds)
+	}
+	if m.AddOperatorsFunc != nil {
+		return m.AddOperatorsFunc(channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// RemoveOperators records the call and calls RemoveOperatorsFunc.
+func (m *OpenChannelService) RemoveOperators(channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("RemoveOperators", nil, channelUrl, userIds)
+	if m.RemoveOperatorsFunc != nil {
+		return m.RemoveOperatorsFunc(channelUrl, userIds)
+	}
+	if m.RemoveOperatorsWithContextFunc != nil {
+		return m.RemoveOperatorsWithContextFunc(context.Background(), channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// RemoveOperatorsWithContext records the call and calls RemoveOperatorsWithContextFunc.
+func (m *OpenChannelService) RemoveOperatorsWithContext(ctx context.Context, channelUrl string, userIds []string) (*sendbird.Response, error) {
+	m.record("RemoveOperatorsWithContext", ctx, channelUrl, userIds)
+	if m.RemoveOperatorsWithContextFunc != nil {
+		return m.RemoveOperatorsWithContextFunc(ctx, channelUrl, userIds)
+	}
+	if m.RemoveOperatorsFunc != nil {
+		return m.RemoveOperatorsFunc(channelUrl, userIds)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Freeze records the call and calls FreezeFunc.
+func (m *OpenChannelService) Freeze(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("Freeze", nil, channelUrl)
+	if m.FreezeFunc != nil {
+		return m.FreezeFunc(channelUrl)
+	}
+	if m.FreezeWithContextFunc != nil {
+		return m.FreezeWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// FreezeWithContext records the call and calls FreezeWithContextFunc.
+func (m *OpenChannelService) FreezeWithContext(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("FreezeWithContext", ctx, channelUrl)
+	if m.FreezeWithContextFunc != nil {
+		return m.FreezeWithContextFunc(ctx, channelUrl)
+	}
+	if m.FreezeFunc != nil {
+		return m.FreezeFunc(channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Unfreeze records the call and calls UnfreezeFunc.
+func (m *OpenChannelService) Unfreeze(channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("Unfreeze", nil, channelUrl)
+	if m.UnfreezeFunc != nil {
+		return m.UnfreezeFunc(channelUrl)
+	}
+	if m.UnfreezeWithContextFunc != nil {
+		return m.UnfreezeWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UnfreezeWithContext records the call and calls UnfreezeWithContextFunc.
+func (m *OpenChannelService) UnfreezeWithContext(ctx context.Context, channelUrl string) (*sendbird.OpenChannel, *sendbird.Response, error) {
+	m.record("UnfreezeWithContext", ctx, channelUrl)
+	if m.UnfreezeWithContextFunc != nil {
+		return m.UnfreezeWithContextFunc(ctx, channelUrl)
+	}
+	if m.UnfreezeFunc != nil {
+		return m.UnfreezeFunc(channelUrl)
+	}
+	var r0 *sendbird.OpenChannel
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Metadata records the call and calls MetadataFunc.
+func (m *OpenChannelService) Metadata(channelUrl string) (map[string]string, *sendbird.Response, error) {
+	m.record("Metadata", nil, channelUrl)
+	if m.MetadataFunc != nil {
+		return m.MetadataFunc(channelUrl)
+	}
+	if m.MetadataWithContextFunc != nil {
+		return m.MetadataWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MetadataWithContext records the call and calls MetadataWithContextFunc.
+func (m *OpenChannelService) MetadataWithContext(ctx context.Context, channelUrl string) (map[string]string, *sendbird.Response, error) {
+	m.record("MetadataWithContext", ctx, channelUrl)
+	if m.MetadataWithContextFunc != nil {
+		return m.MetadataWithContextFunc(ctx, channelUrl)
+	}
+	if m.MetadataFunc != nil {
+		return m.MetadataFunc(channelUrl)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateMetadata records the call and calls CreateMetadataFunc.
+func (m *OpenChannelService) CreateMetadata(channelUrl string, metadata map[string]string) (map[string]string, *sendbird.Response, error) {
+	m.record("CreateMetadata", nil, channelUrl, metadata)
+	if m.CreateMetadataFunc != nil {
+		return m.CreateMetadataFunc(channelUrl, metadata)
+	}
+	if m.CreateMetadataWithContextFunc != nil {
+		return m.CreateMetadataWithContextFunc(context.Background(), channelUrl, metadata)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateMetadataWithContext records the call and calls CreateMetadataWithContextFunc.
+func (m *OpenChannelService) CreateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string) (map[string]string, *sendbird.Response, error) {
+	m.record("CreateMetadataWithContext", ctx, channelUrl, metadata)
+	if m.CreateMetadataWithContextFunc != nil {
+		return m.CreateMetadataWithContextFunc(ctx, channelUrl, metadata)
+	}
+	if m.CreateMetadataFunc != nil {
+		return m.CreateMetadataFunc(channelUrl, metadata)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateMetadata records the call and calls UpdateMetadataFunc.
+func (m *OpenChannelService) UpdateMetadata(channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error) {
+	m.record("UpdateMetadata", nil, channelUrl, metadata, upsert)
+	if m.UpdateMetadataFunc != nil {
+		return m.UpdateMetadataFunc(channelUrl, metadata, upsert)
+	}
+	if m.UpdateMetadataWithContextFunc != nil {
+		return m.UpdateMetadataWithContextFunc(context.Background(), channelUrl, metadata, upsert)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateMetadataWithContext records the call and calls UpdateMetadataWithContextFunc.
+func (m *OpenChannelService) UpdateMetadataWithContext(ctx context.Context, channelUrl string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error) {
+	m.record("UpdateMetadataWithContext", ctx, channelUrl, metadata, upsert)
+	if m.UpdateMetadataWithContextFunc != nil {
+		return m.UpdateMetadataWithContextFunc(ctx, channelUrl, metadata, upsert)
+	}
+	if m.UpdateMetadataFunc != nil {
+		return m.UpdateMetadataFunc(channelUrl, metadata, upsert)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteMetadata records the call and calls DeleteMetadataFunc.
+func (m *OpenChannelService) DeleteMetadata(channelUrl string, key string) (*sendbird.Response, error) {
+	m.record("DeleteMetadata", nil, channelUrl, key)
+	if m.DeleteMetadataFunc != nil {
+		return m.DeleteMetadataFunc(channelUrl, key)
+	}
+	if m.DeleteMetadataWithContextFunc != nil {
+		return m.DeleteMetadataWithContextFunc(context.Background(), channelUrl, key)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteMetadataWithContext records the call and calls DeleteMetadataWithContextFunc.
+func (m *OpenChannelService) DeleteMetadataWithContext(ctx context.Context, channelUrl string, key string) (*sendbird.Response, error) {
+	m.record("DeleteMetadataWithContext", ctx, channelUrl, key)
+	if m.DeleteMetadataWithContextFunc != nil {
+		return m.DeleteMetadataWithContextFunc(ctx, channelUrl, key)
+	}
+	if m.DeleteMetadataFunc != nil {
+		return m.DeleteMetadataFunc(channelUrl, key)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAllMetadata records the call and calls DeleteAllMetadataFunc.
+func (m *OpenChannelService) DeleteAllMetadata(channelUrl string) (*sendbird.Response, error) {
+	m.record("DeleteAllMetadata", nil, channelUrl)
+	if m.DeleteAllMetadataFunc != nil {
+		return m.DeleteAllMetadataFunc(channelUrl)
+	}
+	if m.DeleteAllMetadataWithContextFunc != nil {
+		return m.DeleteAllMetadataWithContextFunc(context.Background(), channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAllMetadataWithContext records the call and calls DeleteAllMetadataWithContextFunc.
+func (m *OpenChannelService) DeleteAllMetadataWithContext(ctx context.Context, channelUrl string) (*sendbird.Response, error) {
+	m.record("DeleteAllMetadataWithContext", ctx, channelUrl)
+	if m.DeleteAllMetadataWithContextFunc != nil {
+		return m.DeleteAllMetadataWithContextFunc(ctx, channelUrl)
+	}
+	if m.DeleteAllMetadataFunc != nil {
+		return m.DeleteAllMetadataFunc(channelUrl)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UserService is a mock of sendbird.UserService. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type UserService struct {
+	Recorder
+
+	CreateFunc                func(arg0 *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	CreateWithContextFunc     func(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	UpdateFunc                func(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	UpdateWithContextFunc     func(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	AuthFunc                  func(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	AuthWithContextFunc       func(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error)
+	BlockFunc                 func(params *sendbird.BlockRequest) (*sendbird.Response, error)
+	BlockWithContextFunc      func(ctx context.Context, params *sendbird.BlockRequest) (*sendbird.Response, error)
+	UnBlockFunc               func(params *sendbird.BlockRequest) (*sendbird.Response, error)
+	UnBlockWithContextFunc    func(ctx context.Context, params *sendbird.BlockRequest) (*sendbird.Response, error)
+	DeactivateFunc            func(params *sendbird.DeactivateRequest) (*sendbird.Response, error)
+	DeactivateWithContextFunc func(ctx context.Context, params *sendbird.DeactivateRequest) (*sendbird.Response, error)
+}
+
+var _ sendbird.UserService = &UserService{}
+
+// Create records the call and calls CreateFunc.
+func (m *UserService) Create(arg0 *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("Create", nil, arg0)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), arg0)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *UserService) CreateWithContext(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *UserService) Update(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("Update", nil, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *UserService) UpdateWithContext(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(params)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Auth records the call and calls AuthFunc.
+func (m *UserService) Auth(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("Auth", nil, params)
+	if m.AuthFunc != nil {
+		return m.AuthFunc(params)
+	}
+	if m.AuthWithContextFunc != nil {
+		return m.AuthWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// AuthWithContext records the call and calls AuthWithContextFunc.
+func (m *UserService) AuthWithContext(ctx context.Context, params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+	m.record("AuthWithContext", ctx, params)
+	if m.AuthWithContextFunc != nil {
+		return m.AuthWithContextFunc(ctx, params)
+	}
+	if m.AuthFunc != nil {
+		return m.AuthFunc(params)
+	}
+	var r0 *sendbird.User
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Block records the call and calls BlockFunc.
+func (m *UserService) Block(params *sendbird.BlockRequest) (*sendbird.Response, error) {
+	m.record("Block", nil, params)
+	if m.BlockFunc != nil {
+		return m.BlockFunc(params)
+	}
+	if m.BlockWithContextFunc != nil {
+		return m.BlockWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// BlockWithContext records the call and calls BlockWithContextFunc.
+func (m *UserService) BlockWithContext(ctx context.Context, params *sendbird.BlockRequest) (*sendbird.Response, error) {
+	m.record("BlockWithContext", ctx, params)
+	if m.BlockWithContextFunc != nil {
+		return m.BlockWithContextFunc(ctx, params)
+	}
+	if m.BlockFunc != nil {
+		return m.BlockFunc(params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UnBlock records the call and calls UnBlockFunc.
+func (m *UserService) UnBlock(params *sendbird.BlockRequest) (*sendbird.Response, error) {
+	m.record("UnBlock", nil, params)
+	if m.UnBlockFunc != nil {
+		return m.UnBlockFunc(params)
+	}
+	if m.UnBlockWithContextFunc != nil {
+		return m.UnBlockWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UnBlockWithContext records the call and calls UnBlockWithContextFunc.
+func (m *UserService) UnBlockWithContext(ctx context.Context, params *sendbird.BlockRequest) (*sendbird.Response, error) {
+	m.record("UnBlockWithContext", ctx, params)
+	if m.UnBlockWithContextFunc != nil {
+		return m.UnBlockWithContextFunc(ctx, params)
+	}
+	if m.UnBlockFunc != nil {
+		return m.UnBlockFunc(params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Deactivate records the call and calls DeactivateFunc.
+func (m *UserService) Deactivate(params *sendbird.DeactivateRequest) (*sendbird.Response, error) {
+	m.record("Deactivate", nil, params)
+	if m.DeactivateFunc != nil {
+		return m.DeactivateFunc(params)
+	}
+	if m.DeactivateWithContextFunc != nil {
+		return m.DeactivateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeactivateWithContext records the call and calls DeactivateWithContextFunc.
+func (m *UserService) DeactivateWithContext(ctx context.Context, params *sendbird.DeactivateRequest) (*sendbird.Response, error) {
+	m.record("DeactivateWithContext", ctx, params)
+	if m.DeactivateWithContextFunc != nil {
+		return m.DeactivateWithContextFunc(ctx, params)
+	}
+	if m.DeactivateFunc != nil {
+		return m.DeactivateFunc(params)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// UserV3Service is a mock of sendbird.UserV3Service. Each method calls the matching Func field, or returns zero values
+// when it is nil. X and XWithContext fall back on each other's Func.
+type UserV3Service struct {
+	Recorder
+
+	CreateFunc                            func(params *sendbird.UserV3CreateRequest) (*sendbird.UserV3, *sendbird.Response, error)
+	CreateWithContextFunc                 func(ctx context.Context, params *sendbird.UserV3CreateRequest) (*sendbird.UserV3, *sendbird.Response, error)
+	ListFunc                              func(opts *sendbird.UserV3ListOptions) (*sendbird.UserV3List, *sendbird.Response, error)
+	ListWithContextFunc                   func(ctx context.Context, opts *sendbird.UserV3ListOptions) (*sendbird.UserV3List, *sendbird.Response, error)
+	GetFunc                               func(userId string) (*sendbird.UserV3, *sendbird.Response, error)
+	GetWithContextFunc                    func(ctx context.Context, userId string) (*sendbird.UserV3, *sendbird.Response, error)
+	UpdateFunc                            func(userId string, params *sendbird.UserV3UpdateRequest) (*sendbird.UserV3, *sendbird.Response, error)
+	UpdateWithContextFunc                 func(ctx context.Context, userId string, params *sendbird.UserV3UpdateRequest) (*sendbird.UserV3, *sendbird.Response, error)
+	DeleteFunc                            func(userId string) (*sendbird.Response, error)
+	DeleteWithContextFunc                 func(ctx context.Context, userId string) (*sendbird.Response, error)
+	IssueSessionTokenFunc                 func(userId string, expiresAt time.Time) (*sendbird.SessionToken, *sendbird.Response, error)
+	IssueSessionTokenWithContextFunc      func(ctx context.Context, userId string, expiresAt time.Time) (*sendbird.SessionToken, *sendbird.Response, error)
+	RevokeSessionTokenFunc                func(userId string, token string) (*sendbird.Response, error)
+	RevokeSessionTokenWithContextFunc     func(ctx context.Context, userId string, token string) (*sendbird.Response, error)
+	RevokeAllSessionTokensFunc            func(userId string) (*sendbird.Response, error)
+	RevokeAllSessionTokensWithContextFunc func(ctx context.Context, userId string) (*sendbird.Response, error)
+	MetadataFunc                          func(userId string) (map[string]string, *sendbird.Response, error)
+	MetadataWithContextFunc               func(ctx context.Context, userId string) (map[string]string, *sendbird.Response, error)
+	CreateMetadataFunc                    func(userId string, metadata map[string]string) (map[string]string, *sendbird.Response, error)
+	CreateMetadataWithContextFunc         func(ctx context.Context, userId string, metadata map[string]string) (map[string]string, *sendbird.Response, error)
+	UpdateMetadataFunc                    func(userId string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error)
+	UpdateMetadataWithContextFunc         func(ctx context.Context, userId string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error)
+	DeleteMetadataFunc                    func(userId string, key string) (*sendbird.Response, error)
+	DeleteMetadataWithContextFunc         func(ctx context.Context, userId string, key string) (*sendbird.Response, error)
+	DeleteAllMetadataFunc                 func(userId string) (*sendbird.Response, error)
+	DeleteAllMetadataWithContextFunc      func(ctx context.Context, userId string) (*sendbird.Response, error)
+}
+
+var _ sendbird.UserV3Service = &UserV3Service{}
+
+// Create records the call and calls CreateFunc.
+func (m *UserV3Service) Create(params *sendbird.UserV3CreateRequest) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("Create", nil, params)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(context.Background(), params)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateWithContext records the call and calls CreateWithContextFunc.
+func (m *UserV3Service) CreateWithContext(ctx context.Context, params *sendbird.UserV3CreateRequest) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("CreateWithContext", ctx, params)
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, params)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(params)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// List records the call and calls ListFunc.
+func (m *UserV3Service) List(opts *sendbird.UserV3ListOptions) (*sendbird.UserV3List, *sendbird.Response, error) {
+	m.record("List", nil, opts)
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(context.Background(), opts)
+	}
+	var r0 *sendbird.UserV3List
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// ListWithContext records the call and calls ListWithContextFunc.
+func (m *UserV3Service) ListWithContext(ctx context.Context, opts *sendbird.UserV3ListOptions) (*sendbird.UserV3List, *sendbird.Response, error) {
+	m.record("ListWithContext", ctx, opts)
+	if m.ListWithContextFunc != nil {
+		return m.ListWithContextFunc(ctx, opts)
+	}
+	if m.ListFunc != nil {
+		return m.ListFunc(opts)
+	}
+	var r0 *sendbird.UserV3List
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Get records the call and calls GetFunc.
+func (m *UserV3Service) Get(userId string) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("Get", nil, userId)
+	if m.GetFunc != nil {
+		return m.GetFunc(userId)
+	}
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// GetWithContext records the call and calls GetWithContextFunc.
+func (m *UserV3Service) GetWithContext(ctx context.Context, userId string) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("GetWithContext", ctx, userId)
+	if m.GetWithContextFunc != nil {
+		return m.GetWithContextFunc(ctx, userId)
+	}
+	if m.GetFunc != nil {
+		return m.GetFunc(userId)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Update records the call and calls UpdateFunc.
+func (m *UserV3Service) Update(userId string, params *sendbird.UserV3UpdateRequest) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("Update", nil, userId, params)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(userId, params)
+	}
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(context.Background(), userId, params)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateWithContext records the call and calls UpdateWithContextFunc.
+func (m *UserV3Service) UpdateWithContext(ctx context.Context, userId string, params *sendbird.UserV3UpdateRequest) (*sendbird.UserV3, *sendbird.Response, error) {
+	m.record("UpdateWithContext", ctx, userId, params)
+	if m.UpdateWithContextFunc != nil {
+		return m.UpdateWithContextFunc(ctx, userId, params)
+	}
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(userId, params)
+	}
+	var r0 *sendbird.UserV3
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *UserV3Service) Delete(userId string) (*sendbird.Response, error) {
+	m.record("Delete", nil, userId)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(userId)
+	}
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteWithContext records the call and calls DeleteWithContextFunc.
+func (m *UserV3Service) DeleteWithContext(ctx context.Context, userId string) (*sendbird.Response, error) {
+	m.record("DeleteWithContext", ctx, userId)
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, userId)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// IssueSessionToken records the call and calls IssueSessionTokenFunc.
+func (m *UserV3Service) IssueSessionToken(userId string, expiresAt time.Time) (*sendbird.SessionToken, *sendbird.Response, error) {
+	m.record("IssueSessionToken", nil, userId, expiresAt)
+	if m.IssueSessionTokenFunc != nil {
+		return m.IssueSessionTokenFunc(userId, expiresAt)
+	}
+	if m.IssueSessionTokenWithContextFunc != nil {
+		return m.IssueSessionTokenWithContextFunc(context.Background(), userId, expiresAt)
+	}
+	var r0 *sendbird.SessionToken
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// IssueSessionTokenWithContext records the call and calls IssueSessionTokenWithContextFunc.
+func (m *UserV3Service) IssueSessionTokenWithContext(ctx context.Context, userId string, expiresAt time.Time) (*sendbird.SessionToken, *sendbird.Response, error) {
+	m.record("IssueSessionTokenWithContext", ctx, userId, expiresAt)
+	if m.IssueSessionTokenWithContextFunc != nil {
+		return m.IssueSessionTokenWithContextFunc(ctx, userId, expiresAt)
+	}
+	if m.IssueSessionTokenFunc != nil {
+		return m.IssueSessionTokenFunc(userId, expiresAt)
+	}
+	var r0 *sendbird.SessionToken
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// RevokeSessionToken records the call and calls RevokeSessionTokenFunc.
+func (m *UserV3Service) RevokeSessionToken(userId string, token string) (*sendbird.Response, error) {
+	m.record("RevokeSessionToken", nil, userId, token)
+	if m.RevokeSessionTokenFunc != nil {
+		return m.RevokeSessionTokenFunc(userId, token)
+	}
+	if m.RevokeSessionTokenWithContextFunc != nil {
+		return m.RevokeSessionTokenWithContextFunc(context.Background(), userId, token)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// RevokeSessionTokenWithContext records the call and calls RevokeSessionTokenWithContextFunc.
+func (m *UserV3Service) RevokeSessionTokenWithContext(ctx context.Context, userId string, token string) (*sendbird.Response, error) {
+	m.record("RevokeSessionTokenWithContext", ctx, userId, token)
+	if m.RevokeSessionTokenWithContextFunc != nil {
+		return m.RevokeSessionTokenWithContextFunc(ctx, userId, token)
+	}
+	if m.RevokeSessionTokenFunc != nil {
+		return m.RevokeSessionTokenFunc(userId, token)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// RevokeAllSessionTokens records the call and calls RevokeAllSessionTokensFunc.
+func (m *UserV3Service) RevokeAllSessionTokens(userId string) (*sendbird.Response, error) {
+	m.record("RevokeAllSessionTokens", nil, userId)
+	if m.RevokeAllSessionTokensFunc != nil {
+		return m.RevokeAllSessionTokensFunc(userId)
+	}
+	if m.RevokeAllSessionTokensWithContextFunc != nil {
+		return m.RevokeAllSessionTokensWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// RevokeAllSessionTokensWithContext records the call and calls RevokeAllSessionTokensWithContextFunc.
+func (m *UserV3Service) RevokeAllSessionTokensWithContext(ctx context.Context, userId string) (*sendbird.Response, error) {
+	m.record("RevokeAllSessionTokensWithContext", ctx, userId)
+	if m.RevokeAllSessionTokensWithContextFunc != nil {
+		return m.RevokeAllSessionTokensWithContextFunc(ctx, userId)
+	}
+	if m.RevokeAllSessionTokensFunc != nil {
+		return m.RevokeAllSessionTokensFunc(userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// Metadata records the call and calls MetadataFunc.
+func (m *UserV3Service) Metadata(userId string) (map[string]string, *sendbird.Response, error) {
+	m.record("Metadata", nil, userId)
+	if m.MetadataFunc != nil {
+		return m.MetadataFunc(userId)
+	}
+	if m.MetadataWithContextFunc != nil {
+		return m.MetadataWithContextFunc(context.Background(), userId)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// MetadataWithContext records the call and calls MetadataWithContextFunc.
+func (m *UserV3Service) MetadataWithContext(ctx context.Context, userId string) (map[string]string, *sendbird.Response, error) {
+	m.record("MetadataWithContext", ctx, userId)
+	if m.MetadataWithContextFunc != nil {
+		return m.MetadataWithContextFunc(ctx, userId)
+	}
+	if m.MetadataFunc != nil {
+		return m.MetadataFunc(userId)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateMetadata records the call and calls CreateMetadataFunc.
+func (m *UserV3Service) CreateMetadata(userId string, metadata map[string]string) (map[string]string, *sendbird.Response, error) {
+	m.record("CreateMetadata", nil, userId, metadata)
+	if m.CreateMetadataFunc != nil {
+		return m.CreateMetadataFunc(userId, metadata)
+	}
+	if m.CreateMetadataWithContextFunc != nil {
+		return m.CreateMetadataWithContextFunc(context.Background(), userId, metadata)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// CreateMetadataWithContext records the call and calls CreateMetadataWithContextFunc.
+func (m *UserV3Service) CreateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string) (map[string]string, *sendbird.Response, error) {
+	m.record("CreateMetadataWithContext", ctx, userId, metadata)
+	if m.CreateMetadataWithContextFunc != nil {
+		return m.CreateMetadataWithContextFunc(ctx, userId, metadata)
+	}
+	if m.CreateMetadataFunc != nil {
+		return m.CreateMetadataFunc(userId, metadata)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateMetadata records the call and calls UpdateMetadataFunc.
+func (m *UserV3Service) UpdateMetadata(userId string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error) {
+	m.record("UpdateMetadata", nil, userId, metadata, upsert)
+	if m.UpdateMetadataFunc != nil {
+		return m.UpdateMetadataFunc(userId, metadata, upsert)
+	}
+	if m.UpdateMetadataWithContextFunc != nil {
+		return m.UpdateMetadataWithContextFunc(context.Background(), userId, metadata, upsert)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// UpdateMetadataWithContext records the call and calls UpdateMetadataWithContextFunc.
+func (m *UserV3Service) UpdateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string, upsert bool) (map[string]string, *sendbird.Response, error) {
+	m.record("UpdateMetadataWithContext", ctx, userId, metadata, upsert)
+	if m.UpdateMetadataWithContextFunc != nil {
+		return m.UpdateMetadataWithContextFunc(ctx, userId, metadata, upsert)
+	}
+	if m.UpdateMetadataFunc != nil {
+		return m.UpdateMetadataFunc(userId, metadata, upsert)
+	}
+	var r0 map[string]string
+	var r1 *sendbird.Response
+	var r2 error
+	return r0, r1, r2
+}
+
+// DeleteMetadata records the call and calls DeleteMetadataFunc.
+func (m *UserV3Service) DeleteMetadata(userId string, key string) (*sendbird.Response, error) {
+	m.record("DeleteMetadata", nil, userId, key)
+	if m.DeleteMetadataFunc != nil {
+		return m.DeleteMetadataFunc(userId, key)
+	}
+	if m.DeleteMetadataWithContextFunc != nil {
+		return m.DeleteMetadataWithContextFunc(context.Background(), userId, key)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteMetadataWithContext records the call and calls DeleteMetadataWithContextFunc.
+func (m *UserV3Service) DeleteMetadataWithContext(ctx context.Context, userId string, key string) (*sendbird.Response, error) {
+	m.record("DeleteMetadataWithContext", ctx, userId, key)
+	if m.DeleteMetadataWithContextFunc != nil {
+		return m.DeleteMetadataWithContextFunc(ctx, userId, key)
+	}
+	if m.DeleteMetadataFunc != nil {
+		return m.DeleteMetadataFunc(userId, key)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAllMetadata records the call and calls DeleteAllMetadataFunc.
+func (m *UserV3Service) DeleteAllMetadata(userId string) (*sendbird.Response, error) {
+	m.record("DeleteAllMetadata", nil, userId)
+	if m.DeleteAllMetadataFunc != nil {
+		return m.DeleteAllMetadataFunc(userId)
+	}
+	if m.DeleteAllMetadataWithContextFunc != nil {
+		return m.DeleteAllMetadataWithContextFunc(context.Background(), userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
+
+// DeleteAllMetadataWithContext records the call and calls DeleteAllMetadataWithContextFunc.
+func (m *UserV3Service) DeleteAllMetadataWithContext(ctx context.Context, userId string) (*sendbird.Response, error) {
+	m.record("DeleteAllMetadataWithContext", ctx, userId)
+	if m.DeleteAllMetadataWithContextFunc != nil {
+		return m.DeleteAllMetadataWithContextFunc(ctx, userId)
+	}
+	if m.DeleteAllMetadataFunc != nil {
+		return m.DeleteAllMetadataFunc(userId)
+	}
+	var r0 *sendbird.Response
+	var r1 error
+	return r0, r1
+}
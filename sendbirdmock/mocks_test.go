@@ -0,0 +1,121 @@
+package sendbirdmock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+	"github.com/ippy04/sendbird/sendbirdmock/internal/mockgen"
+)
+
+func TestMocksAreUpToDate(t *testing.T) {
+	generated, err := mockgen.Generate("..")
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+	current, err := ioutil.ReadFile("mocks_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, current) {
+		t.Errorf("mocks_gen.go is out of date with the sendbird interfaces, run go generate")
+	}
+}
+
+func TestMockReturnsConfiguredValues(t *testing.T) {
+	users := &UserService{
+		CreateFunc: func(params *sendbird.UserRequest) (*sendbird.User, *sendbird.Response, error) {
+			return &sendbird.User{Id: params.Id}, nil, nil
+		},
+	}
+
+	client, _ := sendbird.NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN")
+	client.Users = users
+
+	user, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john"})
+	if err != nil || user.Id != "john" {
+		t.Errorf("Create returned %+v, %v", user, err)
+	}
+
+	// the context variant falls back on CreateFunc
+	user, _, err = client.Users.CreateWithContext(context.Background(), &sendbird.UserRequest{Id: "jane"})
+	if err != nil || user.Id != "jane" {
+		t.Errorf("CreateWithContext returned %+v, %v", user, err)
+	}
+
+	// unconfigured methods return zero values
+	if user, resp, err := client.Users.Auth(&sendbird.UserRequest{Id: "john"}); user != nil || resp != nil || err != nil {
+		t.Errorf("Auth returned %+v, %v, %v, expected zero values", user, resp, err)
+	}
+}
+
+func TestMockRecordsCalls(t *testing.T) {
+	failure := errors.New("boom")
+	bots := &BotService{
+		SendMessageWithContextFunc: func(ctx context.Context, botUserId string, params *sendbird.BotMessageRequest) (*sendbird.BotMessage, *sendbird.Response, error) {
+			return nil, nil, failure
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), "key", "value")
+	params := &sendbird.BotMessageRequest{Message: "hi", ChannelUrl: "url"}
+	if _, _, err := bots.SendMessage("helper_bot", params); err != failure {
+		t.Errorf("SendMessage returned %v, expected the error of SendMessageWithContextFunc", err)
+	}
+	bots.SendMessageWithContext(ctx, "helper_bot", params)
+	bots.Shutdown(ctx)
+
+	calls := bots.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("recorded %d calls, expected 3", len(calls))
+	}
+	if calls[0].Method != "SendMessage" || calls[0].Context != nil {
+		t.Errorf("first call recorded as %+v", calls[0])
+	}
+	if calls[1].Method != "SendMessageWithContext" || calls[1].Context != ctx {
+		t.Errorf("second call recorded as %+v, expected its context", calls[1])
+	}
+
+	bots.AssertCalled(t, "SendMessage", "helper_bot", &sendbird.BotMessageRequest{Message: "hi", ChannelUrl: "url"})
+	bots.AssertCalled(t, "Shutdown")
+	bots.AssertCallCount(t, "SendMessageWithContext", 1)
+	bots.AssertNotCalled(t, "Delete")
+
+	bots.Reset()
+	if len(bots.Calls()) != 0 {
+		t.Errorf("calls kept after Reset")
+	}
+}
+
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestMockAssertionsFail(t *testing.T) {
+	admin := &AdminService{}
+	admin.MuteAllChannels("john")
+
+	ft := &fakeT{}
+	if admin.AssertCalled(ft, "MuteAllChannels", "jane") {
+		t.Errorf("AssertCalled passed with other arguments")
+	}
+	if admin.AssertNotCalled(ft, "MuteAllChannels") {
+		t.Errorf("AssertNotCalled passed for a called method")
+	}
+	if admin.AssertCallCount(ft, "MuteAllChannels", 2) {
+		t.Errorf("AssertCallCount passed with the wrong count")
+	}
+	if len(ft.errors) != 3 {
+		t.Errorf("assertions reported %d failures, expected 3", len(ft.errors))
+	}
+}
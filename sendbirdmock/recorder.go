@@ -0,0 +1,101 @@
+package sendbirdmock
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// Call is a call recorded by a mock.
+type Call struct {
+	Method  string
+	Context context.Context // nil for methods without a context
+	Args    []interface{}   // arguments other than the context
+}
+
+// TestingT is the part of *testing.T used by the assertion helpers.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Recorder records the calls made to a mock. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, ctx context.Context, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Context: ctx, Args: args})
+}
+
+// Calls returns the recorded calls in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to a method. X and XWithContext are different methods.
+func (r *Recorder) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range r.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Called reports whether method was called with args, compared with reflect.DeepEqual. Without args any call
+// to method matches.
+func (r *Recorder) Called(method string, args ...interface{}) bool {
+	for _, call := range r.CallsTo(method) {
+		if len(args) == 0 || reflect.DeepEqual(call.Args, args) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+// AssertCalled fails t unless method was called with args, see Called.
+func (r *Recorder) AssertCalled(t TestingT, method string, args ...interface{}) bool {
+	t.Helper()
+	if !r.Called(method, args...) {
+		t.Errorf("expected a call to %s%v, got calls %v", method, args, r.Calls())
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled fails t if method was called.
+func (r *Recorder) AssertNotCalled(t TestingT, method string) bool {
+	t.Helper()
+	if calls := r.CallsTo(method); len(calls) > 0 {
+		t.Errorf("expected no call to %s, got %v", method, calls)
+		return false
+	}
+	return true
+}
+
+// AssertCallCount fails t unless method was called n times.
+func (r *Recorder) AssertCallCount(t TestingT, method string, n int) bool {
+	t.Helper()
+	if calls := r.CallsTo(method); len(calls) != n {
+		t.Errorf("expected %d calls to %s, got %d", n, method, len(calls))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+// Package sendbirdotel instruments a sendbird.SendbirdClient with OpenTelemetry. Every API call gets a client
+// span named after the service method that issued it (e.g. "ChatChannel.Send") and is recorded in a latency
+// histogram and, when it fails, in an error counter.
+package sendbirdotel
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ippy04/sendbird"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/ippy04/sendbird/sendbirdotel"
+
+	// maximum amount of an error body read to find the Sendbird error code
+	maxErrorBody = 64 << 10
+)
+
+// Attribute keys recorded on spans and metrics.
+const (
+	OperationKey  = attribute.Key("sendbird.operation")
+	EndpointKey   = attribute.Key("sendbird.endpoint")
+	ErrorCodeKey  = attribute.Key("sendbird.error_code")
+	MethodKey     = attribute.Key("http.request.method")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider. Defaults to the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider. Defaults to the global one.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// Instrument installs the instrumentation middleware on c.
+func Instrument(c *sendbird.SendbirdClient, opts ...Option) error {
+	mw, err := Middleware(opts...)
+	if err != nil {
+		return err
+	}
+	c.Use(mw)
+	return nil
+}
+
+// Middleware returns the instrumentation as a sendbird.Middleware, to be installed with sendbird.WithMiddleware
+// or SendbirdClient.Use. Install it first so that it measures the whole call, retries included.
+func Middleware(opts ...Option) (sendbird.Middleware, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram("sendbird.client.request.duration",
+		metric.WithDescription("Duration of Sendbird API calls"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	errorCount, err := meter.Int64Counter("sendbird.client.request.errors",
+		metric.WithDescription("Number of failed Sendbird API calls"),
+		metric.WithUnit("{call}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next sendbird.RoundTripFunc) sendbird.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			operation := sendbird.OperationName(req.Context())
+			endpoint := sendbird.RequestEndpointFamily(req)
+			spanName := operation
+			if spanName == "" {
+				spanName = req.Method + " " + endpoint
+			}
+
+			attrs := []attribute.KeyValue{
+				OperationKey.String(operation),
+				EndpointKey.String(endpoint),
+				MethodKey.String(req.Method),
+			}
+
+			ctx, span := tracer.Start(req.Context(), spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...))
+			defer span.End()
+
+			start := time.Now()
+			resp, err := next(req.WithContext(ctx))
+			elapsed := time.Since(start)
+
+			failed := err != nil
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			} else {
+				attrs = append(attrs, StatusCodeKey.Int(resp.StatusCode))
+				span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+
+				if resp.StatusCode >= 400 {
+					failed = true
+					if code := peekErrorCode(resp); code != 0 {
+						attrs = append(attrs, ErrorCodeKey.Int(code))
+						span.SetAttributes(ErrorCodeKey.Int(code))
+					}
+					span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+				}
+			}
+
+			set := metric.WithAttributes(attrs...)
+			duration.Record(ctx, elapsed.Seconds(), set)
+			if failed {
+				errorCount.Add(ctx, 1, set)
+			}
+
+			return resp, err
+		}
+	}, nil
+}
+
+// peekErrorCode returns the Sendbird error code of an error response, leaving the body readable for Do.
+func peekErrorCode(resp *http.Response) int {
+	if resp.Body == nil {
+		return 0
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	if err != nil {
+		return 0
+	}
+
+	var body struct {
+		Code int `json:"code"`
+	}
+	json.Unmarshal(data, &body)
+	return body.Code
+}
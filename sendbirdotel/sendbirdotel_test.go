@@ -0,0 +1,140 @@
+package sendbirdotel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) (*sendbird.SendbirdClient, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := sendbird.NewClient("SENDBIRD_APP_ID", "SENDBIRD_API_TOKEN", sendbird.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	err = Instrument(client,
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+	if err != nil {
+		t.Fatalf("Instrument returned error: %v", err)
+	}
+
+	return client, spans, reader
+}
+
+func TestSpanPerCall(t *testing.T) {
+	client, spans, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "1", ChannelUrl: "url"}); err != nil {
+		t.Fatalf("Chat.Send returned error: %v", err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("recorded %d spans, expected 1", len(ended))
+	}
+
+	span := ended[0]
+	if span.Name() != "ChatChannel.Send" {
+		t.Errorf("span name = %q, expected %q", span.Name(), "ChatChannel.Send")
+	}
+
+	attrs := attribute.NewSet(span.Attributes()...)
+	if v, _ := attrs.Value(EndpointKey); v.AsString() != sendbird.EndpointChannel {
+		t.Errorf("span endpoint = %q, expected %q", v.AsString(), sendbird.EndpointChannel)
+	}
+	if v, _ := attrs.Value(StatusCodeKey); v.AsInt64() != http.StatusOK {
+		t.Errorf("span status code = %d, expected %d", v.AsInt64(), http.StatusOK)
+	}
+	if span.Status().Code == codes.Error {
+		t.Errorf("span status = %v, expected no error", span.Status())
+	}
+
+	rm := collect(t, reader)
+	if n := histogramCount(rm, "sendbird.client.request.duration"); n != 1 {
+		t.Errorf("duration histogram count = %d, expected 1", n)
+	}
+	if n := counterSum(rm, "sendbird.client.request.errors"); n != 0 {
+		t.Errorf("error count = %d, expected 0", n)
+	}
+}
+
+func TestErrorCodeRecorded(t *testing.T) {
+	client, spans, reader := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": true, "message": "Channel not found.", "code": 400201}`)
+	})
+
+	_, _, err := client.Chat.View("missing")
+	if !sendbird.IsChannelNotFound(err) {
+		t.Fatalf("Chat.View returned %v, expected a channel not found error", err)
+	}
+
+	span := spans.Ended()[0]
+	if span.Name() != "ChatChannel.View" || span.Status().Code != codes.Error {
+		t.Errorf("span = %q with status %v", span.Name(), span.Status())
+	}
+
+	attrs := attribute.NewSet(span.Attributes()...)
+	if v, _ := attrs.Value(ErrorCodeKey); v.AsInt64() != sendbird.ErrCodeResourceNotFound {
+		t.Errorf("span error code = %d, expected %d", v.AsInt64(), sendbird.ErrCodeResourceNotFound)
+	}
+
+	if n := counterSum(collect(t, reader), "sendbird.client.request.errors"); n != 1 {
+		t.Errorf("error count = %d, expected 1", n)
+	}
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) metricdata.ResourceMetrics {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	return rm
+}
+
+func histogramCount(rm metricdata.ResourceMetrics, name string) uint64 {
+	var n uint64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if h, ok := m.Data.(metricdata.Histogram[float64]); ok && m.Name == name {
+				for _, dp := range h.DataPoints {
+					n += dp.Count
+				}
+			}
+		}
+	}
+	return n
+}
+
+func counterSum(rm metricdata.ResourceMetrics, name string) int64 {
+	var n int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if s, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == name {
+				for _, dp := range s.DataPoints {
+					n += dp.Value
+				}
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,254 @@
+package sendbirdtest
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/ippy04/sendbird"
+)
+
+// anyChannel returns an existing channel of either family, or a not found error.
+func (s *Server) anyChannel(url string) (*channel, error) {
+	ch, ok := s.channels[url]
+	if !ok {
+		return nil, errorf(sendbird.ErrCodeResourceNotFound, "\"Channel\" not found: %q", url)
+	}
+	return ch, nil
+}
+
+// broadcastMessage sends an admin message to channels. Only persistent messages are stored.
+func (s *Server) broadcastMessage(r *request) (interface{}, error) {
+	params := sendbird.BroadcastMessageRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	channels := []*channel{}
+	for _, url := range params.ChannelUrls {
+		ch, err := s.anyChannel(url)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, ch)
+	}
+
+	if params.Persistent {
+		for _, ch := range channels {
+			s.addMessage(ch, "", params.Message, params.Data)
+		}
+	}
+	return struct{}{}, nil
+}
+
+// readMessages returns up to limit messages of a channel, oldest first, before message_id when it is set.
+func (s *Server) readMessages(r *request) (interface{}, error) {
+	params := sendbird.ReadMessagesRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	var ch *channel
+	if params.ChannelUrl != "" {
+		var err error
+		if ch, err = s.anyChannel(params.ChannelUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		ch = s.channelOf(params.TargetUserIds)
+		if ch == nil {
+			return nil, errorf(sendbird.ErrCodeResourceNotFound, "\"Channel\" not found: %q", params.TargetUserIds)
+		}
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	messages := []sendbird.AdminMessage{}
+	for i := len(s.messages) - 1; i >= 0 && len(messages) < limit; i-- {
+		m := s.messages[i]
+		if m.ChannelUrl != ch.url || params.MessageId > 0 && m.MessageId >= params.MessageId {
+			continue
+		}
+
+		message := sendbird.AdminMessage{
+			Id:        m.UserId,
+			MessageId: m.MessageId,
+			Timestamp: m.Timestamp,
+			Message:   m.Message,
+		}
+		if user, ok := s.users[m.UserId]; ok {
+			message.Nickname = user.Nickname
+		}
+		messages = append(messages, message)
+	}
+
+	// collected newest first
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages, nil
+}
+
+// channelOf returns the messaging channel whose members are exactly userIds, or nil.
+func (s *Server) channelOf(userIds []string) *channel {
+	for _, ch := range s.channels {
+		if ch.kind != "messaging" || len(ch.members) != len(userIds) {
+			continue
+		}
+		match := true
+		for _, id := range userIds {
+			match = match && ch.isMember(id)
+		}
+		if match {
+			return ch
+		}
+	}
+	return nil
+}
+
+func (s *Server) deleteMessage(r *request) (interface{}, error) {
+	var params struct {
+		MsgId string `json:"msg_id"`
+	}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.ParseInt(params.MsgId, 10, 64)
+	for i, m := range s.messages {
+		if m.MessageId == id {
+			s.messages = append(s.messages[:i], s.messages[i+1:]...)
+			return sendbird.DeleteMessage{MsgId: id}, nil
+		}
+	}
+	return nil, errorf(sendbird.ErrCodeResourceNotFound, "\"Message\" not found: %q", params.MsgId)
+}
+
+// listMessagingChannels returns the messaging channels of a user, except those the user hid.
+func (s *Server) listMessagingChannels(r *request) (interface{}, error) {
+	var params struct {
+		Id string `json:"id"`
+	}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.Id); err != nil {
+		return nil, err
+	}
+
+	channels := []*channel{}
+	for _, ch := range s.channels {
+		if ch.kind == "messaging" && ch.isMember(params.Id) && !ch.hidden[params.Id] {
+			channels = append(channels, ch)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].id < channels[j].id })
+
+	list := []sendbird.AdminMessagingChannel{}
+	for _, ch := range channels {
+		item := sendbird.AdminMessagingChannel{ChannelUrl: ch.url, Members: s.members(ch)}
+		for _, m := range s.messages {
+			if m.ChannelUrl != ch.url {
+				continue
+			}
+			if m.UserId != params.Id {
+				item.UnreadMessageCount++
+			}
+			item.LastMessage = m.Message
+			item.LastMessageTS = m.Timestamp
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+// muteRequest is a mute or unmute request, application-wide when it has no channel_urls.
+type muteRequest struct {
+	Id          string    `json:"id"`
+	ChannelUrls *[]string `json:"channel_urls"`
+}
+
+func (s *Server) mute(r *request) (interface{}, error) {
+	return s.setMuted(r, true)
+}
+
+func (s *Server) unmute(r *request) (interface{}, error) {
+	return s.setMuted(r, false)
+}
+
+func (s *Server) setMuted(r *request, muted bool) (interface{}, error) {
+	params := muteRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.Id); err != nil {
+		return nil, err
+	}
+
+	if params.ChannelUrls == nil {
+		s.appMuted[params.Id] = muted
+		return struct{}{}, nil
+	}
+
+	urls := []string{}
+	for _, url := range *params.ChannelUrls {
+		ch, err := s.anyChannel(url)
+		if err != nil {
+			return nil, err
+		}
+		ch.muted[params.Id] = muted
+		urls = append(urls, url)
+	}
+	return urls, nil
+}
+
+// muteList returns the users muted in the given channels, or muted application-wide when none are given.
+func (s *Server) muteList(r *request) (interface{}, error) {
+	var params struct {
+		ChannelUrls []string `json:"channel_urls"`
+	}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	if len(params.ChannelUrls) == 0 {
+		return sortedKeys(s.appMuted), nil
+	}
+
+	muted := map[string]bool{}
+	for _, url := range params.ChannelUrls {
+		ch, err := s.anyChannel(url)
+		if err != nil {
+			return nil, err
+		}
+		for id, ok := range ch.muted {
+			muted[id] = muted[id] || ok
+		}
+	}
+	return sortedKeys(muted), nil
+}
+
+// concurrentUserCount is always zero, as no user is connected to the fake.
+func (s *Server) concurrentUserCount(r *request) (interface{}, error) {
+	return sendbird.ConcurrentUserCount{}, nil
+}
+
+func (s *Server) memberCount(r *request) (interface{}, error) {
+	var params struct {
+		ChannelUrl string `json:"channel_url"`
+	}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.anyChannel(params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendbird.ChannelMemberCount{
+		AccumulatedMemberCount: len(ch.joined),
+		MemberCount:            len(ch.members),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package sendbirdtest
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestAdminMessages(t *testing.T) {
+	_, client := newTestClient(t)
+
+	client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John"})
+	client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "lobby"})
+	for _, text := range []string{"one", "two", "three"} {
+		client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "lobby", Message: text})
+	}
+	client.Admin.BroadcastMessage(&sendbird.BroadcastMessageRequest{ChannelUrls: []string{"lobby"}, Message: "transient"})
+
+	messages, _, err := client.Admin.ReadMessages(&sendbird.ReadMessagesRequest{ChannelUrl: "lobby", Limit: 2})
+	if err != nil {
+		t.Fatalf("Admin.ReadMessages returned error: %v", err)
+	}
+	if len(messages) != 2 || messages[0].Message != "two" || messages[1].Message != "three" || messages[1].Nickname != "John" {
+		t.Fatalf("Admin.ReadMessages returned %+v, expected the last two messages", messages)
+	}
+
+	older, _, _ := client.Admin.ReadMessages(&sendbird.ReadMessagesRequest{ChannelUrl: "lobby", MessageId: messages[0].MessageId})
+	if len(older) != 1 || older[0].Message != "one" {
+		t.Errorf("Admin.ReadMessages before %d returned %+v", messages[0].MessageId, older)
+	}
+
+	deleted, _, err := client.Admin.DeleteMessage(strconv.FormatInt(messages[0].MessageId, 10))
+	if err != nil || deleted.MsgId != messages[0].MessageId {
+		t.Errorf("Admin.DeleteMessage returned %+v, %v", deleted, err)
+	}
+	if count, _, _ := client.Chat.MessageCount("lobby"); count.MessageCount != 2 {
+		t.Errorf("channel holds %d messages after Admin.DeleteMessage, expected 2", count.MessageCount)
+	}
+}
+
+func TestAdminMute(t *testing.T) {
+	_, client := newTestClient(t)
+
+	client.Users.Create(&sendbird.UserRequest{Id: "john"})
+	client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "lobby"})
+	client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "hall"})
+
+	muted, _, err := client.Admin.Mute(&sendbird.MuteRequest{Id: "john", ChannelUrls: []string{"lobby"}})
+	if err != nil || !reflect.DeepEqual(muted, []string{"lobby"}) {
+		t.Errorf("Admin.Mute returned %q, %v", muted, err)
+	}
+
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "lobby", Message: "hi"}); err == nil {
+		t.Errorf("muted user could send a message")
+	}
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "hall", Message: "hi"}); err != nil {
+		t.Errorf("user muted in another channel couldn't send a message: %v", err)
+	}
+
+	if ids, _, _ := client.Admin.MuteList([]string{"lobby", "hall"}); !reflect.DeepEqual(ids, []string{"john"}) {
+		t.Errorf("Admin.MuteList returned %q, expected john", ids)
+	}
+
+	client.Admin.UnMute(&sendbird.UnMuteRequest{Id: "john", ChannelUrls: []string{"lobby"}})
+	client.Admin.MuteAllChannels("john")
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "hall", Message: "hi"}); err == nil {
+		t.Errorf("user muted in all channels could send a message")
+	}
+	if ids, _, _ := client.Admin.MuteList(nil); !reflect.DeepEqual(ids, []string{"john"}) {
+		t.Errorf("Admin.MuteList of the application returned %q, expected john", ids)
+	}
+
+	client.Admin.UnMuteAllChannels("john")
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "lobby", Message: "hi"}); err != nil {
+		t.Errorf("unmuted user couldn't send a message: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package sendbirdtest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/ippy04/sendbird"
+)
+
+// serveBots serves the /v2/bots endpoints.
+func (s *Server) serveBots(r *request) (interface{}, error) {
+	switch {
+	case len(r.path) == 0 && r.method == "GET":
+		return s.listBots()
+	case len(r.path) == 0 && r.method == "POST":
+		return s.createBot(r)
+	case len(r.path) == 1 && r.method == "GET":
+		return s.bot(r.path[0])
+	case len(r.path) == 1 && r.method == "POST":
+		return s.updateBot(r, r.path[0])
+	case len(r.path) == 1 && r.method == "DELETE":
+		return s.deleteBot(r.path[0])
+	case len(r.path) == 2 && r.path[1] == "send" && r.method == "POST":
+		return s.sendBotMessage(r, r.path[0])
+	}
+	return nil, &apiError{status: http.StatusNotFound, code: sendbird.ErrCodeResourceNotFound, message: "Resource not found"}
+}
+
+func (s *Server) bot(botUserId string) (*sendbird.Bot, error) {
+	bot, ok := s.bots[botUserId]
+	if !ok {
+		return nil, errorf(sendbird.ErrCodeResourceNotFound, "\"Bot\" not found: %q", botUserId)
+	}
+	return bot, nil
+}
+
+func (s *Server) listBots() (interface{}, error) {
+	bots := []sendbird.Bot{}
+	for _, bot := range s.bots {
+		bots = append(bots, *bot)
+	}
+	sort.Slice(bots, func(i, j int) bool { return bots[i].BotUserId < bots[j].BotUserId })
+	return bots, nil
+}
+
+// createBot adds a bot, along with its user.
+func (s *Server) createBot(r *request) (interface{}, error) {
+	params := sendbird.BotRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if params.BotUserId == "" {
+		return nil, errorf(ErrCodeInvalidValue, "Invalid value: \"bot_userid\".")
+	}
+	if _, ok := s.bots[params.BotUserId]; ok {
+		return nil, errorf(ErrCodeUniqueViolation, "\"bot_userid\" violates a unique constraint.")
+	}
+
+	bot := &sendbird.Bot{
+		BotToken:       newToken(),
+		BotUserId:      params.BotUserId,
+		BotNickname:    params.BotNickname,
+		BotCallbackUrl: params.BotCallbackUrl,
+		IsPrivacyMode:  params.IsPrivacyMode,
+	}
+	s.bots[bot.BotUserId] = bot
+
+	if _, ok := s.users[bot.BotUserId]; !ok {
+		s.users[bot.BotUserId] = &sendbird.User{
+			Id:       bot.BotUserId,
+			UserId:   bot.BotUserId,
+			Nickname: bot.BotNickname,
+			IsActive: true,
+		}
+	}
+	return bot, nil
+}
+
+func (s *Server) updateBot(r *request, botUserId string) (interface{}, error) {
+	params := sendbird.BotUpdateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	bot, err := s.bot(botUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	bot.BotNickname = params.BotNickname
+	bot.BotCallbackUrl = params.BotCallbackUrl
+	bot.IsPrivacyMode = params.IsPrivacyMode
+	return bot, nil
+}
+
+func (s *Server) deleteBot(botUserId string) (interface{}, error) {
+	if _, err := s.bot(botUserId); err != nil {
+		return nil, err
+	}
+
+	delete(s.bots, botUserId)
+	return sendbird.BotUserId{BotUserId: botUserId}, nil
+}
+
+func (s *Server) sendBotMessage(r *request, botUserId string) (interface{}, error) {
+	params := sendbird.BotMessageRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.bot(botUserId); err != nil {
+		return nil, err
+	}
+	ch, err := s.anyChannel(params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	s.addMessage(ch, botUserId, params.Message, params.Data)
+	return sendbird.BotMessage{
+		BotUserId:  botUserId,
+		Message:    params.Message,
+		Data:       params.Data,
+		ChannelUrl: ch.url,
+	}, nil
+}
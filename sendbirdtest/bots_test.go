@@ -0,0 +1,45 @@
+package sendbirdtest
+
+import (
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestBots(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	bot, _, err := client.Bot.Create(&sendbird.BotRequest{BotUserId: "helper_bot", BotNickname: "Helper", BotCallbackUrl: "https://example.com/bot"})
+	if err != nil || bot.BotToken == "" || bot.BotCallbackUrl != "https://example.com/bot" {
+		t.Fatalf("Bot.Create returned %+v, %v", bot, err)
+	}
+	if _, ok := srv.User("helper_bot"); !ok {
+		t.Errorf("Bot.Create didn't create the bot's user")
+	}
+
+	bots, _, err := client.Bot.List()
+	if err != nil || len(bots) != 1 || bots[0].BotToken != bot.BotToken {
+		t.Errorf("Bot.List returned %+v, %v", bots, err)
+	}
+
+	updated, _, err := client.Bot.Update("helper_bot", &sendbird.BotUpdateRequest{BotNickname: "Helper", BotCallbackUrl: "https://example.com/v2"})
+	if err != nil || updated.BotCallbackUrl != "https://example.com/v2" {
+		t.Errorf("Bot.Update returned %+v, %v", updated, err)
+	}
+
+	client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "lobby"})
+	message, _, err := client.Bot.SendMessage("helper_bot", &sendbird.BotMessageRequest{ChannelUrl: "lobby", Message: "hello"})
+	if err != nil || message.Message != "hello" {
+		t.Errorf("Bot.SendMessage returned %+v, %v", message, err)
+	}
+	if messages := srv.Messages("lobby"); len(messages) != 1 || messages[0].UserId != "helper_bot" {
+		t.Errorf("channel holds %+v, expected the bot's message", messages)
+	}
+
+	if _, _, err := client.Bot.Delete("helper_bot"); err != nil {
+		t.Fatalf("Bot.Delete returned error: %v", err)
+	}
+	if _, _, err := client.Bot.Get("helper_bot"); !sendbird.IsNotFound(err) {
+		t.Errorf("Bot.Get of a deleted bot returned %v, expected a not found error", err)
+	}
+}
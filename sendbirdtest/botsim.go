@@ -0,0 +1,193 @@
+package sendbirdtest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/ippy04/sendbird"
+)
+
+// CallbackOption customizes a callback built by a BotSimulator.
+type CallbackOption func(cb *sendbird.BotCallback)
+
+// Mentioning mentions users in the callback's message.
+func Mentioning(usernames ...string) CallbackOption {
+	return func(cb *sendbird.BotCallback) {
+		cb.Mentioned = append(cb.Mentioned, usernames...)
+	}
+}
+
+// WithData sets the custom data of the callback's message.
+func WithData(data string) CallbackOption {
+	return func(cb *sendbird.BotCallback) {
+		cb.Data = data
+	}
+}
+
+// InGroupChannel sends the callback from a group messaging channel rather than a 1 on 1 one.
+func InGroupChannel() CallbackOption {
+	return func(cb *sendbird.BotCallback) {
+		cb.ChannelType = "group_messaging"
+	}
+}
+
+// WithBotToken replaces the bot's token. Deliver still signs the callback validly, so only a handler with a
+// TokenLookup rejects it, e.g. a BotServer or a BotServiceOp given one.
+func WithBotToken(token string) CallbackOption {
+	return func(cb *sendbird.BotCallback) {
+		cb.BotToken = token
+	}
+}
+
+// Delivery is the outcome of delivering a callback to a bot.
+type Delivery struct {
+	Callback *sendbird.BotCallback
+
+	// Status and body the handler answered with
+	Status int
+	Body   string
+
+	// Messages the bot sent to the fake server from the start of the delivery until the handler answered
+	Replies []Message
+
+	after int64
+}
+
+// BotSimulator delivers realistic bot callbacks to a handler, such as a BotServiceOp or BotServer, and collects
+// what the bot sent back through the fake server. The bot must reply with a client of the server.
+//
+// With the default dispatcher the handler answers before the bot replies; use a synchronous dispatcher or
+// WaitForReplies.
+type BotSimulator struct {
+	Server  *Server
+	Handler http.Handler
+}
+
+// NewBotSimulator returns a simulator delivering callbacks to handler, for bots of srv.
+func NewBotSimulator(srv *Server, handler http.Handler) *BotSimulator {
+	return &BotSimulator{Server: srv, Handler: handler}
+}
+
+// Callback builds the callback of a message sent by sender to a bot of the server in a channel. The bot's token
+// and nickname come from the server, as does the sender's nickname when the user exists. A messaging channel
+// holding the bot and the sender is created on the server when it doesn't exist, so that the bot can reply.
+func (s *BotSimulator) Callback(botUserId, sender, channelUrl, message string, opts ...CallbackOption) (*sendbird.BotCallback, error) {
+	srv := s.Server
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	bot, ok := srv.bots[botUserId]
+	if !ok {
+		return nil, fmt.Errorf("sendbirdtest: no bot %q on the server", botUserId)
+	}
+
+	cb := &sendbird.BotCallback{
+		BotUserId:      bot.BotUserId,
+		Category:       "bot_message_notification",
+		Timestamp:      srv.timestamp(),
+		BotToken:       bot.BotToken,
+		BotNickname:    bot.BotNickname,
+		SenderUsername: sender,
+		Message:        message,
+		ChannelType:    "messaging",
+		ChannelURl:     channelUrl,
+	}
+	if user, ok := srv.users[sender]; ok {
+		cb.SenderNickname = user.Nickname
+	}
+	for _, opt := range opts {
+		opt(cb)
+	}
+
+	ch, ok := srv.channels[channelUrl]
+	if !ok {
+		ch, _ = srv.newChannel(&request{family: "messaging"}, channelUrl)
+		ch.isGroup = cb.ChannelType == "group_messaging"
+		ch.join(sender)
+		ch.join(bot.BotUserId)
+		cb.ChannelURl = ch.url
+	}
+	if ch.isGroup {
+		cb.ChannelType = "group_messaging"
+	}
+	return cb, nil
+}
+
+// Send builds a callback, see Callback, and delivers it.
+func (s *BotSimulator) Send(botUserId, sender, channelUrl, message string, opts ...CallbackOption) (*Delivery, error) {
+	cb, err := s.Callback(botUserId, sender, channelUrl, message, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return s.Deliver(cb)
+}
+
+// Deliver posts a callback to the handler the way Sendbird does, signed with the server's API token.
+func (s *BotSimulator) Deliver(cb *sendbird.BotCallback) (*Delivery, error) {
+	body, err := json.Marshal(cb)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(ApiToken))
+	mac.Write(body)
+
+	req := httptest.NewRequest("POST", "/sendbird_bot", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(sendbird.SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+	d := &Delivery{Callback: cb, after: s.Server.lastMessageId()}
+
+	rec := httptest.NewRecorder()
+	s.Handler.ServeHTTP(rec, req)
+
+	d.Status = rec.Code
+	d.Body = rec.Body.String()
+	d.Replies = s.Server.messagesOf(cb.BotUserId, cb.ChannelURl, d.after)
+	return d, nil
+}
+
+// WaitForReplies waits until the bot sent at least n messages in reply to a delivery, or timeout elapsed. It
+// returns the replies sent so far, and updates d.Replies.
+func (s *BotSimulator) WaitForReplies(d *Delivery, n int, timeout time.Duration) ([]Message, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		d.Replies = s.Server.messagesOf(d.Callback.BotUserId, d.Callback.ChannelURl, d.after)
+		if len(d.Replies) >= n {
+			return d.Replies, nil
+		}
+		if time.Now().After(deadline) {
+			return d.Replies, fmt.Errorf("sendbirdtest: bot sent %d replies within %v, expected %d", len(d.Replies), timeout, n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// lastMessageId returns the latest id handed out, messages sent afterwards have larger ids.
+func (s *Server) lastMessageId() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.nextId
+}
+
+// messagesOf returns the messages a user sent to a channel after a message id.
+func (s *Server) messagesOf(userId, channelUrl string, after int64) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var messages []Message
+	for _, m := range s.messages {
+		if m.UserId == userId && m.ChannelUrl == channelUrl && m.MessageId > after {
+			messages = append(messages, *m)
+		}
+	}
+	return messages
+}
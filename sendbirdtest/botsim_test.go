@@ -0,0 +1,89 @@
+package sendbirdtest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ippy04/sendbird"
+)
+
+// newTestBot returns a bot of srv answering "/echo <text>" commands.
+func newTestBot(t *testing.T, srv *Server, config sendbird.DispatcherConfig) *sendbird.BotServiceOp {
+	client, _ := srv.NewClient()
+	if _, _, err := client.Bot.Create(&sendbird.BotRequest{BotUserId: "echo_bot", BotNickname: "Echo"}); err != nil {
+		t.Fatalf("Bot.Create returned error: %v", err)
+	}
+
+	router := sendbird.NewBotRouter(client.Bot)
+	router.Command("/echo <text>", func(e *sendbird.BotEvent) error {
+		_, err := e.Reply(e.Arg("text"))
+		return err
+	})
+	router.Mention(func(e *sendbird.BotEvent) error {
+		_, err := e.ReplyWithData("hi "+e.Callback.SenderNickname, e.Callback.ChannelType)
+		return err
+	})
+
+	bots := client.Bot.(*sendbird.BotServiceOp)
+	bot, _ := srv.Bot("echo_bot")
+	bots.TokenLookup = sendbird.StaticBotTokens(map[string]string{"echo_bot": bot.BotToken})
+	bots.Dispatcher = sendbird.NewBotDispatcher(router.HandleCallback, config)
+	return bots
+}
+
+func TestBotSimulator(t *testing.T) {
+	srv, client := newTestClient(t)
+	client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John"})
+
+	sim := NewBotSimulator(srv, newTestBot(t, srv, sendbird.DispatcherConfig{Synchronous: true}))
+
+	d, err := sim.Send("echo_bot", "john", "dm", "/echo hello there")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if d.Status != http.StatusOK || len(d.Replies) != 1 || d.Replies[0].Message != "hello there" {
+		t.Errorf("delivery answered %d with replies %+v", d.Status, d.Replies)
+	}
+	if members := srv.Members("dm"); !reflect.DeepEqual(members, []string{"john", "echo_bot"}) {
+		t.Errorf("simulated channel has members %q", members)
+	}
+
+	d, _ = sim.Send("echo_bot", "john", "team", "@echo_bot", Mentioning("echo_bot"), InGroupChannel())
+	if len(d.Replies) != 1 || d.Replies[0].Message != "hi John" || d.Replies[0].Data != "group_messaging" {
+		t.Errorf("mention got replies %+v", d.Replies)
+	}
+
+	d, _ = sim.Send("echo_bot", "john", "dm", "/echo forged", WithBotToken("FORGED"))
+	if d.Status != http.StatusUnauthorized || len(d.Replies) != 0 {
+		t.Errorf("forged callback answered %d with replies %+v", d.Status, d.Replies)
+	}
+
+	if _, err := sim.Send("missing_bot", "john", "dm", "hi"); err == nil {
+		t.Errorf("Send to a missing bot returned no error")
+	}
+}
+
+func TestBotSimulatorWaitForReplies(t *testing.T) {
+	srv, _ := newTestClient(t)
+
+	bots := newTestBot(t, srv, sendbird.DispatcherConfig{})
+	defer bots.Shutdown(context.Background())
+
+	sim := NewBotSimulator(srv, bots)
+	d, err := sim.Send("echo_bot", "john", "dm", "/echo later")
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	replies, err := sim.WaitForReplies(d, 1, time.Second)
+	if err != nil || len(replies) != 1 || replies[0].Message != "later" {
+		t.Errorf("WaitForReplies returned %+v, %v", replies, err)
+	}
+
+	if _, err := sim.WaitForReplies(d, 2, 20*time.Millisecond); err == nil {
+		t.Errorf("WaitForReplies for a reply never sent returned no error")
+	}
+}
@@ -0,0 +1,264 @@
+package sendbirdtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CassetteMode selects whether a Cassette records or replays traffic.
+type CassetteMode int
+
+const (
+	// Replay answers requests from the cassette file, without network.
+	Replay CassetteMode = iota
+
+	// Record sends requests to the real API and records them, to be written with Save.
+	Record
+
+	// ReplayOrRecord replays the cassette file when it exists, and records otherwise.
+	ReplayOrRecord
+)
+
+// scrubbedFields are the credentials removed from recorded traffic: from request bodies and query parameters,
+// and at any depth from response bodies.
+var scrubbedFields = []string{"auth", "api_token", "access_token", "bot_token", "session_token", "token"}
+
+// Interaction is a request recorded in a cassette along with its response.
+type Interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`  // path and query, without credentials
+		Body   string `json:"body"` // normalized JSON without credentials, or the raw body
+	} `json:"request"`
+	Response struct {
+		Status int         `json:"status"`
+		Header http.Header `json:"header"`
+		Body   string      `json:"body"` // without credentials
+	} `json:"response"`
+}
+
+// Cassette is an http.RoundTripper recording the traffic of a client to a file and replaying it. Requests are
+// matched on method, path and normalized JSON body; identical requests are answered in the order they were
+// recorded. Install it with
+//
+//	sb, _ := sendbird.NewClient(appId, apiToken, sendbird.WithHTTPClient(&http.Client{Transport: cassette}))
+type Cassette struct {
+	// Transport sending the recorded requests, http.DefaultTransport when nil
+	Transport http.RoundTripper
+
+	path      string
+	recording bool
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewCassette opens the cassette stored at path. In Replay mode the file must exist.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{path: path}
+
+	if mode == ReplayOrRecord {
+		mode = Replay
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			mode = Record
+		}
+	}
+
+	if mode == Record {
+		c.recording = true
+		return c, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("sendbirdtest: reading cassette %s: %v", path, err)
+	}
+	c.used = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// Recording reports whether the cassette records traffic rather than replaying it.
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// RoundTrip records or replays a request. The body of req is consumed, the request sent on is a clone carrying a
+// copy of it.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		clone := req.Clone(req.Context())
+		clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+		clone.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req = clone
+	}
+
+	url := scrubURL(req)
+	normalized := normalizeBody(body)
+
+	if c.recording {
+		return c.record(req, url, normalized)
+	}
+	return c.replay(req, url, normalized)
+}
+
+func (c *Cassette) record(req *http.Request, url, body string) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	var i Interaction
+	i.Request.Method = req.Method
+	i.Request.URL = url
+	i.Request.Body = body
+	i.Response.Status = resp.StatusCode
+	i.Response.Header = resp.Header.Clone()
+	i.Response.Body = scrubBody(respBody)
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, i)
+	c.mu.Unlock()
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, url, body string) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for n, i := range c.interactions {
+		if c.used[n] || i.Request.Method != req.Method || i.Request.URL != url || i.Request.Body != body {
+			continue
+		}
+		c.used[n] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.Status, http.StatusText(i.Response.Status)),
+			StatusCode:    i.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        i.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(i.Response.Body))),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("sendbirdtest: no recorded interaction left in %s for %s %s %s", c.path, req.Method, url, body)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its directory. It does nothing when the
+// cassette replays.
+func (c *Cassette) Save() error {
+	if !c.recording {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, append(data, '\n'), 0644)
+}
+
+// scrubURL returns the path and query of a request, without credentials.
+func scrubURL(req *http.Request) string {
+	query := req.URL.Query()
+	for _, field := range scrubbedFields {
+		query.Del(field)
+	}
+
+	url := req.URL.Path
+	if encoded := query.Encode(); encoded != "" {
+		url += "?" + encoded
+	}
+	return url
+}
+
+// normalizeBody re-encodes a JSON object body with sorted keys and without credentials. Other bodies are kept
+// as they are.
+func normalizeBody(body []byte) string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return string(body)
+	}
+	for _, field := range scrubbedFields {
+		delete(fields, field)
+	}
+
+	normalized, _ := json.Marshal(fields)
+	return string(normalized)
+}
+
+// scrubBody removes the credential fields, at any depth, from a JSON response body. Other bodies, and bodies
+// without credentials, are kept as they are.
+func scrubBody(body []byte) string {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil || !scrubValue(v) {
+		return string(body)
+	}
+
+	scrubbed, _ := json.Marshal(v)
+	return string(scrubbed)
+}
+
+// scrubValue deletes the credential fields of the objects in v and reports whether it found any.
+func scrubValue(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, field := range scrubbedFields {
+			if _, ok := v[field]; ok {
+				delete(v, field)
+				found = true
+			}
+		}
+		for _, val := range v {
+			found = scrubValue(val) || found
+		}
+	case []interface{}:
+		for _, val := range v {
+			found = scrubValue(val) || found
+		}
+	}
+	return found
+}
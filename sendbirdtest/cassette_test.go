@@ -0,0 +1,128 @@
+package sendbirdtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "users.json")
+
+	srv := NewServer()
+	cassette, err := NewCassette(path, ReplayOrRecord)
+	if err != nil {
+		t.Fatalf("NewCassette returned error: %v", err)
+	}
+	if !cassette.Recording() {
+		t.Fatalf("cassette without a file doesn't record")
+	}
+
+	client, _ := srv.NewClient(sendbird.WithHTTPClient(&http.Client{Transport: cassette}))
+	recorded, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John", IssueAccessToken: true})
+	if err != nil {
+		t.Fatalf("User.Create returned error: %v", err)
+	}
+	client.Users.Create(&sendbird.UserRequest{Id: "john"})
+	client.Bot.List()
+
+	if err := cassette.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	srv.Close()
+
+	if recorded.AccessToken == "" {
+		t.Fatalf("User.Create issued no access token")
+	}
+	data, _ := ioutil.ReadFile(path)
+	for _, secret := range []string{ApiToken, recorded.AccessToken} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette holds the credential %q:\n%s", secret, data)
+		}
+	}
+
+	// replay with the server gone, and keys sent in another order
+	cassette, err = NewCassette(path, ReplayOrRecord)
+	if err != nil {
+		t.Fatalf("NewCassette returned error: %v", err)
+	}
+	if cassette.Recording() {
+		t.Fatalf("cassette with a file records")
+	}
+
+	client, _ = sendbird.NewClient(AppId, "ANOTHER_TOKEN",
+		sendbird.WithBaseURL(srv.URL),
+		sendbird.WithHTTPClient(&http.Client{Transport: cassette}),
+	)
+
+	replayed, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John", IssueAccessToken: true})
+	if err != nil {
+		t.Fatalf("replayed User.Create returned error: %v", err)
+	}
+	expected := *recorded
+	expected.AccessToken = ""
+	if *replayed != expected {
+		t.Errorf("replayed User.Create returned %+v, expected %+v", replayed, expected)
+	}
+
+	if _, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john"}); err == nil {
+		t.Errorf("replayed error response returned no error")
+	}
+
+	if bots, _, err := client.Bot.List(); err != nil || len(bots) != 0 {
+		t.Errorf("replayed Bot.List returned %+v, %v", bots, err)
+	}
+
+	// every interaction is used once
+	if _, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John", IssueAccessToken: true}); err == nil {
+		t.Errorf("interaction replayed twice")
+	}
+
+	if _, _, err := client.Users.Create(&sendbird.UserRequest{Id: "jane"}); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Errorf("unrecorded request returned %v, expected a missing interaction error", err)
+	}
+}
+
+func TestCassetteLeavesRequestUntouched(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	cassette, _ := NewCassette(filepath.Join(t.TempDir(), "bots.json"), Record)
+	client, _ := srv.NewClient()
+
+	req, _ := client.NewRequest("POST", "v2/bots", &sendbird.BotRequest{BotUserId: "helper_bot"})
+	body := req.Body
+	if _, err := cassette.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip returned error: %v", err)
+	}
+	if req.Body != body {
+		t.Errorf("RoundTrip replaced the body of the caller's request")
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	body := scrubBody([]byte(`{"bots": [{"bot_userid": "helper_bot", "bot_token": "SECRET"}], "count": 12345678901234567890}`))
+	if strings.Contains(body, "SECRET") || !strings.Contains(body, "helper_bot") || !strings.Contains(body, "12345678901234567890") {
+		t.Errorf("scrubbed body = %s", body)
+	}
+
+	if raw := `{"nickname": "John"}`; scrubBody([]byte(raw)) != raw {
+		t.Errorf("body without credentials was rewritten")
+	}
+}
+
+func TestNormalizeBody(t *testing.T) {
+	a := normalizeBody([]byte(`{"nickname": "John", "id": "john", "auth": "TOKEN"}`))
+	b := normalizeBody([]byte(`{"id":"john","nickname":"John"}`))
+	if a != b {
+		t.Errorf("normalized bodies differ: %s and %s", a, b)
+	}
+
+	if raw := normalizeBody([]byte("not json")); raw != "not json" {
+		t.Errorf("non JSON body normalized to %q", raw)
+	}
+}
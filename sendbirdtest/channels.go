@@ -0,0 +1,358 @@
+package sendbirdtest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ippy04/sendbird"
+)
+
+// channel returns an existing channel of the request's family, or a not found error.
+func (s *Server) channel(r *request, url string) (*channel, error) {
+	ch, ok := s.channels[url]
+	if !ok || ch.kind != r.family {
+		return nil, errorf(sendbird.ErrCodeResourceNotFound, "\"Channel\" not found: %q", url)
+	}
+	return ch, nil
+}
+
+// newChannel adds a channel of the request's family, with a generated url when url is empty.
+func (s *Server) newChannel(r *request, url string) (*channel, error) {
+	id := s.newId()
+	if url == "" {
+		url = fmt.Sprintf("sendbird_%s_%d", r.family, id)
+	}
+	if _, ok := s.channels[url]; ok {
+		return nil, errorf(ErrCodeUniqueViolation, "\"channel_url\" violates a unique constraint.")
+	}
+
+	ch := &channel{
+		id:          int(id),
+		kind:        r.family,
+		url:         url,
+		createdAt:   s.timestamp(),
+		joined:      map[string]bool{},
+		hidden:      map[string]bool{},
+		muted:       map[string]bool{},
+		metadata:    map[string]string{},
+		metacounter: map[string]int{},
+	}
+	s.channels[url] = ch
+	return ch, nil
+}
+
+// deleteChannel removes a channel and its messages.
+func (s *Server) deleteChannel(ch *channel) {
+	delete(s.channels, ch.url)
+
+	messages := s.messages[:0]
+	for _, m := range s.messages {
+		if m.ChannelUrl != ch.url {
+			messages = append(messages, m)
+		}
+	}
+	s.messages = messages
+}
+
+func (ch *channel) isMember(id string) bool {
+	for _, member := range ch.members {
+		if member == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (ch *channel) join(id string) {
+	if !ch.isMember(id) {
+		ch.members = append(ch.members, id)
+	}
+	ch.joined[id] = true
+}
+
+func (ch *channel) leave(id string) {
+	for i, member := range ch.members {
+		if member == id {
+			ch.members = append(ch.members[:i], ch.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// members describes the members of a channel.
+func (s *Server) members(ch *channel) []sendbird.Member {
+	members := []sendbird.Member{}
+	for _, id := range ch.members {
+		member := sendbird.Member{Id: id}
+		if user, ok := s.users[id]; ok {
+			member.Name = user.Nickname
+			member.Image = user.Picture
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// addMessage stores a message sent to a channel, unhiding the channel for its members.
+func (s *Server) addMessage(ch *channel, userId, message, data string) *Message {
+	m := &Message{
+		MessageId:  s.newId(),
+		ChannelUrl: ch.url,
+		UserId:     userId,
+		Message:    message,
+		Data:       data,
+		Timestamp:  s.timestamp(),
+	}
+	s.messages = append(s.messages, m)
+	ch.hidden = map[string]bool{}
+	return m
+}
+
+// lastMessage returns the latest message of a channel, or nil.
+func (s *Server) lastMessage(ch *channel) *Message {
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].ChannelUrl == ch.url {
+			return s.messages[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) countMessages(ch *channel) int {
+	count := 0
+	for _, m := range s.messages {
+		if m.ChannelUrl == ch.url {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Server) chatChannel(ch *channel) sendbird.ChatChannel {
+	return sendbird.ChatChannel{
+		Id:            ch.id,
+		Name:          ch.name,
+		ChannelUrl:    ch.url,
+		MemberCount:   len(ch.members),
+		CoverUrl:      ch.coverUrl,
+		CoverImageUrl: ch.coverUrl,
+		Data:          ch.data,
+		CreatedAt:     sendbird.EpochMillis{Time: time.UnixMilli(ch.createdAt)},
+	}
+}
+
+func (s *Server) createChatChannel(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	ch, err := s.newChannel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+	ch.name = params.Name
+	ch.coverUrl = params.CoverUrl
+	ch.data = params.Data
+	return s.chatChannel(ch), nil
+}
+
+func (s *Server) listChatChannels(r *request) (interface{}, error) {
+	channels := []sendbird.ChatChannel{}
+	for _, ch := range s.channels {
+		if ch.kind == r.family {
+			channels = append(channels, s.chatChannel(ch))
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Id < channels[j].Id })
+	return channels, nil
+}
+
+func (s *Server) updateChatChannel(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelUpdateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	url := params.ChannelUrl
+	if url == "" {
+		url = params.TargetChannelUrl
+	}
+	ch, err := s.channel(r, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Name != "" {
+		ch.name = params.Name
+	}
+	if params.CoverUrl != "" {
+		ch.coverUrl = params.CoverUrl
+	}
+	if params.Data != "" {
+		ch.data = params.Data
+	}
+	if params.Ops != nil {
+		ch.ops = append([]string(nil), params.Ops...)
+	}
+	return sendbird.ChatChannelUpdate{ChatChannel: s.chatChannel(ch), Ops: ch.ops}, nil
+}
+
+func (s *Server) deleteChatChannel(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	s.deleteChannel(ch)
+	return struct{}{}, nil
+}
+
+// viewChatChannel describes an open channel. Its members are the users who sent a message to it.
+func (s *Server) viewChatChannel(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendbird.ChatChannelView{ChatChannel: s.chatChannel(ch), Members: s.members(ch)}, nil
+}
+
+func (s *Server) sendChatMessage(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelMessageRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.Id); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+	if s.appMuted[params.Id] || ch.muted[params.Id] {
+		return nil, errorf(ErrCodeUserMuted, "User is muted: %q", params.Id)
+	}
+
+	ch.join(params.Id)
+	s.addMessage(ch, params.Id, params.Message, params.Data)
+	return struct{}{}, nil
+}
+
+func (s *Server) messageCount(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendbird.MessageCount{MessageCount: s.countMessages(ch)}, nil
+}
+
+func (s *Server) getMetadata(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelMetadataRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for k, v := range ch.metadata {
+		if len(params.Keys) == 0 || contains(params.Keys, k) {
+			values[k] = v
+		}
+	}
+	return values, nil
+}
+
+func (s *Server) setMetadata(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelSetMetadataRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for k, v := range params.Data {
+		ch.metadata[k] = v
+		values[k] = v
+	}
+	return values, nil
+}
+
+func (s *Server) getMetacounter(r *request) (interface{}, error) {
+	params := sendbird.ChatChannelMetacounterRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]int{}
+	for k, v := range ch.metacounter {
+		if len(params.Keys) == 0 || contains(params.Keys, k) {
+			values[k] = v
+		}
+	}
+	return values, nil
+}
+
+func (s *Server) setMetacounter(r *request) (interface{}, error) {
+	return s.updateMetacounter(r, func(current, value int) int { return value })
+}
+
+func (s *Server) incrMetacounter(r *request) (interface{}, error) {
+	return s.updateMetacounter(r, func(current, value int) int { return current + value })
+}
+
+func (s *Server) decrMetacounter(r *request) (interface{}, error) {
+	return s.updateMetacounter(r, func(current, value int) int { return current - value })
+}
+
+// updateMetacounter applies update to the counters of the request and returns their new values.
+func (s *Server) updateMetacounter(r *request, update func(current, value int) int) (interface{}, error) {
+	params := sendbird.ChatChannelSetMetacounterRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]int{}
+	for k, v := range params.Data {
+		ch.metacounter[k] = update(ch.metacounter[k], v)
+		values[k] = ch.metacounter[k]
+	}
+	return values, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
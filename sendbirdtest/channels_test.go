@@ -0,0 +1,95 @@
+package sendbirdtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestChatChannels(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	channel, _, err := client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "lobby", Name: "Lobby", CoverUrl: "cover.jpg"})
+	if err != nil {
+		t.Fatalf("ChatChannel.Create returned error: %v", err)
+	}
+	if channel.ChannelUrl != "lobby" || channel.Name != "Lobby" || channel.CoverUrl != "cover.jpg" {
+		t.Errorf("ChatChannel.Create returned %+v", channel)
+	}
+	client.Chat.Create(&sendbird.ChatChannelRequest{Name: "Generated"})
+
+	channels, _, err := client.Chat.List()
+	if err != nil || len(channels) != 2 || channels[0].ChannelUrl != "lobby" {
+		t.Errorf("ChatChannel.List returned %+v, %v", channels, err)
+	}
+
+	updated, _, err := client.Chat.Update(&sendbird.ChatChannelUpdateRequest{ChannelUrl: "lobby", Name: "Hall", Ops: []string{"john"}})
+	if err != nil || updated.Name != "Hall" || !reflect.DeepEqual(updated.Ops, []string{"john"}) {
+		t.Errorf("ChatChannel.Update returned %+v, %v", updated, err)
+	}
+
+	client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John"})
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "john", ChannelUrl: "lobby", Message: "hi"}); err != nil {
+		t.Fatalf("ChatChannel.Send returned error: %v", err)
+	}
+	if _, err := client.Chat.Send(&sendbird.ChatChannelMessageRequest{Id: "jane", ChannelUrl: "lobby", Message: "hi"}); !sendbird.IsUserNotFound(err) {
+		t.Errorf("ChatChannel.Send from a missing user returned %v, expected a user not found error", err)
+	}
+
+	view, _, err := client.Chat.View("lobby")
+	if err != nil || !reflect.DeepEqual(view.Members, []sendbird.Member{{Id: "john", Name: "John"}}) {
+		t.Errorf("ChatChannel.View returned %+v, %v", view, err)
+	}
+
+	count, _, err := client.Chat.MessageCount("lobby")
+	if err != nil || count.MessageCount != 1 {
+		t.Errorf("ChatChannel.MessageCount returned %+v, %v", count, err)
+	}
+
+	if _, err := client.Chat.Delete("lobby"); err != nil {
+		t.Fatalf("ChatChannel.Delete returned error: %v", err)
+	}
+	if _, _, err := client.Chat.View("lobby"); !sendbird.IsChannelNotFound(err) {
+		t.Errorf("ChatChannel.View of a deleted channel returned %v, expected a channel not found error", err)
+	}
+	if messages := srv.Messages("lobby"); len(messages) != 0 {
+		t.Errorf("messages of a deleted channel kept: %+v", messages)
+	}
+}
+
+func TestChannelMetadata(t *testing.T) {
+	_, client := newTestClient(t)
+
+	client.Chat.Create(&sendbird.ChatChannelRequest{ChannelUrl: "lobby"})
+
+	_, _, err := client.Chat.SetMetadata(&sendbird.ChatChannelSetMetadataRequest{
+		ChannelUrl: "lobby",
+		Data:       map[string]string{"topic": "go", "lang": "en"},
+	})
+	if err != nil {
+		t.Fatalf("ChatChannel.SetMetadata returned error: %v", err)
+	}
+
+	metadata, _, err := client.Chat.GetMetadata(&sendbird.ChatChannelMetadataRequest{ChannelUrl: "lobby", Keys: []string{"topic"}})
+	if err != nil || !reflect.DeepEqual(metadata, map[string]string{"topic": "go"}) {
+		t.Errorf("ChatChannel.GetMetadata returned %v, %v", metadata, err)
+	}
+
+	client.Chat.SetMetacounter(&sendbird.ChatChannelSetMetacounterRequest{ChannelUrl: "lobby", Data: map[string]int{"likes": 3}})
+	client.Chat.IncreaseMetacounter(&sendbird.ChatChannelSetMetacounterRequest{ChannelUrl: "lobby", Data: map[string]int{"likes": 2, "views": 1}})
+	counters, _, err := client.Chat.DecreaseMetacounter(&sendbird.ChatChannelSetMetacounterRequest{ChannelUrl: "lobby", Data: map[string]int{"likes": 1}})
+	if err != nil || !reflect.DeepEqual(counters, map[string]int{"likes": 4}) {
+		t.Errorf("ChatChannel.DecreaseMetacounter returned %v, %v", counters, err)
+	}
+
+	counters, _, err = client.Chat.GetMetacounter(&sendbird.ChatChannelMetacounterRequest{ChannelUrl: "lobby"})
+	if err != nil || !reflect.DeepEqual(counters, map[string]int{"likes": 4, "views": 1}) {
+		t.Errorf("ChatChannel.GetMetacounter returned %v, %v", counters, err)
+	}
+
+	// metadata of a channel isn't reachable through the other family
+	if _, _, err := client.Messaging.GetMetadata(&sendbird.MessagingChannelMetadataRequest{ChannelUrl: "lobby"}); !sendbird.IsChannelNotFound(err) {
+		t.Errorf("MessagingChannel.GetMetadata of an open channel returned %v, expected a channel not found error", err)
+	}
+}
@@ -0,0 +1,161 @@
+package sendbirdtest
+
+import (
+	"github.com/ippy04/sendbird"
+)
+
+func (s *Server) messagingChannel(ch *channel) sendbird.MessagingChannel {
+	return sendbird.MessagingChannel{
+		ChannelUrl: ch.url,
+		Data:       ch.data,
+		Name:       ch.name,
+		IsGroup:    ch.isGroup,
+		CoverUrl:   ch.coverUrl,
+	}
+}
+
+func channelUrl(ch *channel) sendbird.MessagingChannelUrl {
+	return sendbird.MessagingChannelUrl{Channel: sendbird.ChannelUrl{ChannelUrl: ch.url}}
+}
+
+func (s *Server) createMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	ch, err := s.newChannel(r, "")
+	if err != nil {
+		return nil, err
+	}
+	ch.name = params.Name
+	ch.isGroup = params.IsGroup
+	ch.coverUrl = params.CoverUrl
+	ch.data = params.Data
+	return sendbird.MessagingChannelResponse{Channel: s.messagingChannel(ch)}, nil
+}
+
+func (s *Server) updateMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelUpdateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Name != "" {
+		ch.name = params.Name
+	}
+	if params.CoverUrl != "" {
+		ch.coverUrl = params.CoverUrl
+	}
+	if params.Data != "" {
+		ch.data = params.Data
+	}
+	if params.IsGroup {
+		ch.isGroup = true
+	}
+	return sendbird.MessagingChannelResponse{Channel: s.messagingChannel(ch)}, nil
+}
+
+func (s *Server) deleteMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelUpdateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	s.deleteChannel(ch)
+	return channelUrl(ch), nil
+}
+
+// inviteMembers adds users to a messaging channel. A 1 on 1 channel holds at most two members.
+func (s *Server) inviteMembers(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelInviteRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	count := len(ch.members)
+	for _, id := range params.UserIds {
+		if _, err := s.user(id); err != nil {
+			return nil, err
+		}
+		if !ch.isMember(id) {
+			count++
+		}
+	}
+	if !ch.isGroup && count > 2 {
+		return nil, errorf(ErrCodeInvalidValue, "Invalid value: a 1 on 1 channel can't have more than 2 members.")
+	}
+
+	for _, id := range params.UserIds {
+		ch.join(id)
+	}
+	return channelUrl(ch), nil
+}
+
+// hideMessagingChannel hides a channel from the channel list of a member until a new message is sent to it.
+func (s *Server) hideMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelHideRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !ch.isMember(params.Id) {
+		return nil, errorf(sendbird.ErrCodeUserNotFound, "User not found in channel: %q", params.Id)
+	}
+
+	ch.hidden[params.Id] = true
+	return channelUrl(ch), nil
+}
+
+func (s *Server) leaveMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelLeaveRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range params.UserIds {
+		ch.leave(id)
+	}
+	return channelUrl(ch), nil
+}
+
+func (s *Server) viewMessagingChannel(r *request) (interface{}, error) {
+	params := sendbird.MessagingChannelUpdateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	ch, err := s.channel(r, params.ChannelUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	view := sendbird.MessagingChannelView{
+		ChannelUrl: ch.url,
+		CreatedAt:  ch.createdAt,
+		Members:    s.members(ch),
+	}
+	if last := s.lastMessage(ch); last != nil {
+		view.LastMessage = last.Message
+		view.LastMessageTS = last.Timestamp
+	}
+	return view, nil
+}
@@ -0,0 +1,65 @@
+package sendbirdtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestMessagingChannels(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	for _, id := range []string{"john", "jane", "bob"} {
+		client.Users.Create(&sendbird.UserRequest{Id: id})
+	}
+
+	channel, _, err := client.Messaging.Create(&sendbird.MessagingChannelRequest{Name: "chat"})
+	if err != nil || channel.ChannelUrl == "" || channel.Name != "chat" {
+		t.Fatalf("MessagingChannel.Create returned %+v, %v", channel, err)
+	}
+	url := channel.ChannelUrl
+
+	if _, _, err := client.Messaging.Invite(&sendbird.MessagingChannelInviteRequest{ChannelUrl: url, UserIds: []string{"john", "jane"}}); err != nil {
+		t.Fatalf("MessagingChannel.Invite returned error: %v", err)
+	}
+	if _, _, err := client.Messaging.Invite(&sendbird.MessagingChannelInviteRequest{ChannelUrl: url, UserIds: []string{"bob"}}); err == nil {
+		t.Errorf("MessagingChannel.Invite of a third member to a 1 on 1 channel returned no error")
+	}
+	if members := srv.Members(url); !reflect.DeepEqual(members, []string{"john", "jane"}) {
+		t.Errorf("channel has members %q, expected john and jane", members)
+	}
+
+	client.Admin.BroadcastMessage(&sendbird.BroadcastMessageRequest{ChannelUrls: []string{url}, Message: "welcome", Persistent: true})
+	view, _, err := client.Messaging.View(url)
+	if err != nil || view.LastMessage != "welcome" || len(view.Members) != 2 {
+		t.Errorf("MessagingChannel.View returned %+v, %v", view, err)
+	}
+
+	if _, _, err := client.Messaging.Hide(&sendbird.MessagingChannelHideRequest{Id: "john", ChannelUrl: url}); err != nil {
+		t.Fatalf("MessagingChannel.Hide returned error: %v", err)
+	}
+	if channels, _, _ := client.Admin.ListMessagingChannels("john"); len(channels) != 0 {
+		t.Errorf("hidden channel listed: %+v", channels)
+	}
+	if channels, _, _ := client.Admin.ListMessagingChannels("jane"); len(channels) != 1 {
+		t.Errorf("channel hidden by another member not listed for jane: %+v", channels)
+	}
+
+	if _, _, err := client.Messaging.Leave(&sendbird.MessagingChannelLeaveRequest{ChannelUrl: url, UserIds: []string{"jane"}}); err != nil {
+		t.Fatalf("MessagingChannel.Leave returned error: %v", err)
+	}
+	if members := srv.Members(url); !reflect.DeepEqual(members, []string{"john"}) {
+		t.Errorf("channel has members %q after Leave, expected john", members)
+	}
+
+	count, _, _ := client.Admin.MemberCountInChannel(url)
+	if count.MemberCount != 1 || count.AccumulatedMemberCount != 2 {
+		t.Errorf("Admin.MemberCountInChannel returned %+v", count)
+	}
+
+	deleted, _, err := client.Messaging.Delete(url)
+	if err != nil || deleted.Channel.ChannelUrl != url {
+		t.Errorf("MessagingChannel.Delete returned %+v, %v", deleted, err)
+	}
+}
@@ -0,0 +1,364 @@
+// Package sendbirdtest provides an in-memory fake of the Sendbird API for integration tests.
+//
+// The fake implements the /user, /channel, /messaging, /admin and /v2/bots endpoints used by the sendbird client
+// with real state: users, channels, members, messages, metadata, metacounters, mutes and bots.
+//
+//	srv := sendbirdtest.NewServer()
+//	defer srv.Close()
+//
+//	sb, _ := srv.NewClient()
+//	sb.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John"})
+package sendbirdtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ippy04/sendbird"
+)
+
+// Credentials of the application served by a Server.
+const (
+	AppId    = "SENDBIRD_TEST_APP"
+	ApiToken = "SENDBIRD_TEST_API_TOKEN"
+)
+
+// Error codes returned by the fake, in addition to those of the sendbird package.
+const (
+	ErrCodeInvalidValue    = 400111
+	ErrCodeUniqueViolation = 400202
+	ErrCodeUserMuted       = 900041
+)
+
+// Message is a message stored by a Server.
+type Message struct {
+	MessageId  int64
+	ChannelUrl string
+	UserId     string // empty for admin messages
+	Message    string
+	Data       string
+	Timestamp  int64 // Epoch timestamp in milliseconds
+}
+
+type channel struct {
+	id          int
+	kind        string // "channel" for open channels, "messaging" for messaging channels
+	url         string
+	name        string
+	coverUrl    string
+	data        string
+	isGroup     bool
+	createdAt   int64
+	ops         []string
+	members     []string
+	joined      map[string]bool // users who were members at least once
+	hidden      map[string]bool
+	muted       map[string]bool
+	metadata    map[string]string
+	metacounter map[string]int
+}
+
+// Server is a fake Sendbird API. It is safe for concurrent use.
+type Server struct {
+	// URL of the fake, to be used as the client's base URL
+	URL string
+
+	srv *httptest.Server
+	now func() time.Time
+
+	mu       sync.Mutex
+	users    map[string]*sendbird.User
+	blocked  map[string]map[string]bool
+	channels map[string]*channel
+	messages []*Message
+	appMuted map[string]bool
+	bots     map[string]*sendbird.Bot
+	nextId   int64
+}
+
+// NewServer starts a fake Sendbird API. Close it when done.
+func NewServer() *Server {
+	s := &Server{now: time.Now}
+	s.Reset()
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// NewClient returns a client of the fake, with the server's credentials and URL. opts are applied after those.
+func (s *Server) NewClient(opts ...sendbird.ClientOption) (*sendbird.SendbirdClient, error) {
+	opts = append([]sendbird.ClientOption{sendbird.WithBaseURL(s.URL)}, opts...)
+	return sendbird.NewClient(AppId, ApiToken, opts...)
+}
+
+// Reset drops all the state of the server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = map[string]*sendbird.User{}
+	s.blocked = map[string]map[string]bool{}
+	s.channels = map[string]*channel{}
+	s.messages = nil
+	s.appMuted = map[string]bool{}
+	s.bots = map[string]*sendbird.Bot{}
+	s.nextId = 0
+}
+
+// User returns a user of the application.
+func (s *Server) User(id string) (sendbird.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return sendbird.User{}, false
+	}
+	return *user, true
+}
+
+// Members returns the ids of the members of a channel.
+func (s *Server) Members(channelUrl string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch, ok := s.channels[channelUrl]
+	if !ok {
+		return nil
+	}
+	return append([]string(nil), ch.members...)
+}
+
+// Messages returns the messages stored in a channel, oldest first.
+func (s *Server) Messages(channelUrl string) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var messages []Message
+	for _, m := range s.messages {
+		if m.ChannelUrl == channelUrl {
+			messages = append(messages, *m)
+		}
+	}
+	return messages
+}
+
+// Bot returns a bot of the application.
+func (s *Server) Bot(botUserId string) (sendbird.Bot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bot, ok := s.bots[botUserId]
+	if !ok {
+		return sendbird.Bot{}, false
+	}
+	return *bot, true
+}
+
+// apiError is the error body of the Sendbird API.
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func errorf(code int, format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// handlerFunc serves one endpoint. It is called with the server locked and returns the value to answer with.
+type handlerFunc func(s *Server, r *request) (interface{}, error)
+
+// request is an API call being served.
+type request struct {
+	method string
+	family string   // first path segment, e.g. "channel" or "messaging"
+	path   []string // path segments after the endpoint family, e.g. ["helper_bot", "send"] for /v2/bots/helper_bot/send
+	body   []byte
+}
+
+// decode unmarshals the body of the request into v.
+func (r *request) decode(v interface{}) error {
+	if len(r.body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.body, v); err != nil {
+		return errorf(ErrCodeInvalidValue, "Invalid value: request body is not valid JSON")
+	}
+	return nil
+}
+
+var routes = map[string]handlerFunc{
+	"/user/create":     (*Server).createUser,
+	"/user/update":     (*Server).updateUser,
+	"/user/auth":       (*Server).authUser,
+	"/user/block":      (*Server).blockUser,
+	"/user/unblock":    (*Server).unblockUser,
+	"/user/deactivate": (*Server).deactivateUser,
+
+	"/channel/create":           (*Server).createChatChannel,
+	"/channel/list":             (*Server).listChatChannels,
+	"/channel/update":           (*Server).updateChatChannel,
+	"/channel/delete":           (*Server).deleteChatChannel,
+	"/channel/view":             (*Server).viewChatChannel,
+	"/channel/send":             (*Server).sendChatMessage,
+	"/channel/get_metadata":     (*Server).getMetadata,
+	"/channel/set_metadata":     (*Server).setMetadata,
+	"/channel/get_metacounter":  (*Server).getMetacounter,
+	"/channel/set_metacounter":  (*Server).setMetacounter,
+	"/channel/incr_metacounter": (*Server).incrMetacounter,
+	"/channel/decr_metacounter": (*Server).decrMetacounter,
+	"/channel/message_count":    (*Server).messageCount,
+
+	"/messaging/create":           (*Server).createMessagingChannel,
+	"/messaging/update":           (*Server).updateMessagingChannel,
+	"/messaging/delete":           (*Server).deleteMessagingChannel,
+	"/messaging/invite":           (*Server).inviteMembers,
+	"/messaging/hide":             (*Server).hideMessagingChannel,
+	"/messaging/leave":            (*Server).leaveMessagingChannel,
+	"/messaging/view":             (*Server).viewMessagingChannel,
+	"/messaging/get_metadata":     (*Server).getMetadata,
+	"/messaging/set_metadata":     (*Server).setMetadata,
+	"/messaging/get_metacounter":  (*Server).getMetacounter,
+	"/messaging/set_metacounter":  (*Server).setMetacounter,
+	"/messaging/incr_metacounter": (*Server).incrMetacounter,
+	"/messaging/decr_metacounter": (*Server).decrMetacounter,
+	"/messaging/message_count":    (*Server).messageCount,
+
+	"/admin/broadcast_message":       (*Server).broadcastMessage,
+	"/admin/read_messages":           (*Server).readMessages,
+	"/admin/delete_message":          (*Server).deleteMessage,
+	"/admin/list_messaging_channels": (*Server).listMessagingChannels,
+	"/admin/mute":                    (*Server).mute,
+	"/admin/unmute":                  (*Server).unmute,
+	"/admin/mute_list":               (*Server).muteList,
+	"/admin/ccu_count":               (*Server).concurrentUserCount,
+	"/admin/member_count":            (*Server).memberCount,
+}
+
+// ServeHTTP serves the API calls. Every call must carry the server's API token, either in the Api-Token header or
+// in the body: the auth field for the v1 endpoints, the api_token field for the v2 ones. Tokens sent as query
+// parameters are refused.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		writeError(w, errorf(ErrCodeInvalidValue, "Invalid value: %v", err))
+		return
+	}
+	r := &request{
+		method: req.Method,
+		family: strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 2)[0],
+		body:   body,
+	}
+
+	var h handlerFunc
+	if req.URL.Path == "/v2/bots" || strings.HasPrefix(req.URL.Path, "/v2/bots/") {
+		r.path = strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/v2/bots"), "/"), "/")
+		if r.path[0] == "" {
+			r.path = nil
+		}
+		h = (*Server).serveBots
+	} else if req.Method == "POST" {
+		h = routes[req.URL.Path]
+	}
+	if h == nil {
+		writeError(w, &apiError{status: http.StatusNotFound, code: sendbird.ErrCodeResourceNotFound, message: "Resource not found"})
+		return
+	}
+
+	if !authorized(req, r) {
+		writeError(w, errorf(sendbird.ErrCodeInvalidAPIToken, "Invalid value: \"API Token\"."))
+		return
+	}
+
+	s.mu.Lock()
+	v, err := h(s, r)
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// authorized reports whether a call carries the server's API token, in the Api-Token header or, as the legacy
+// endpoints accept it, in the body. Tokens in the query string are refused.
+func authorized(req *http.Request, r *request) bool {
+	if req.Header.Get("Api-Token") == ApiToken {
+		return true
+	}
+
+	var credentials struct {
+		Auth     string `json:"auth"`
+		ApiToken string `json:"api_token"`
+	}
+	json.Unmarshal(r.body, &credentials)
+
+	return credentials.Auth == ApiToken || credentials.ApiToken == ApiToken
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error":   true,
+		"code":    apiErr.code,
+		"message": apiErr.message,
+	})
+}
+
+// timestamp returns the current time in milliseconds.
+func (s *Server) timestamp() int64 {
+	return s.now().UnixNano() / int64(time.Millisecond)
+}
+
+// newId returns a new id for channels and messages.
+func (s *Server) newId() int64 {
+	s.nextId++
+	return s.nextId
+}
+
+// newToken returns a random token.
+func newToken() string {
+	b := make([]byte, 20)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// sortedKeys returns the keys of a set in order.
+func sortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for k, ok := range set {
+		if ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
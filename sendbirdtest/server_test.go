@@ -0,0 +1,78 @@
+package sendbirdtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+// newTestClient starts a server and returns a client of it.
+func newTestClient(t *testing.T) (*Server, *sendbird.SendbirdClient) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	return srv, client
+}
+
+func TestServerRejectsInvalidToken(t *testing.T) {
+	srv, _ := newTestClient(t)
+
+	client, _ := sendbird.NewClient(AppId, "WRONG_TOKEN", sendbird.WithBaseURL(srv.URL))
+
+	_, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john"})
+	if !sendbird.IsUnauthorized(err) {
+		t.Errorf("User.Create with a wrong token returned %v, expected an unauthorized error", err)
+	}
+
+	_, _, err = client.Bot.List()
+	if !sendbird.IsUnauthorized(err) {
+		t.Errorf("Bot.List with a wrong token returned %v, expected an unauthorized error", err)
+	}
+
+	if _, ok := srv.User("john"); ok {
+		t.Errorf("user created with a wrong token")
+	}
+}
+
+func TestServerCredentials(t *testing.T) {
+	srv, _ := newTestClient(t)
+
+	for _, tt := range []struct {
+		url, body string
+		expected  int
+	}{
+		{"/user/create", `{"id": "john", "auth": "` + ApiToken + `"}`, http.StatusOK},
+		{"/v2/bots", `{"bot_userid": "helper_bot", "api_token": "` + ApiToken + `"}`, http.StatusOK},
+		{"/user/create?api_token=" + ApiToken, `{"id": "jane"}`, http.StatusBadRequest},
+	} {
+		resp, err := http.Post(srv.URL+tt.url, "application/json", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != tt.expected {
+			t.Errorf("POST %s %s answered %d, expected %d", tt.url, tt.body, resp.StatusCode, tt.expected)
+		}
+	}
+}
+
+func TestServerReset(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	client.Users.Create(&sendbird.UserRequest{Id: "john"})
+	srv.Reset()
+
+	if _, ok := srv.User("john"); ok {
+		t.Errorf("user kept after Reset")
+	}
+	if _, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john"}); err != nil {
+		t.Errorf("User.Create after Reset returned error: %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package sendbirdtest
+
+import (
+	"github.com/ippy04/sendbird"
+)
+
+// user returns an existing user, or a user not found error.
+func (s *Server) user(id string) (*sendbird.User, error) {
+	user, ok := s.users[id]
+	if !ok {
+		return nil, errorf(sendbird.ErrCodeUserNotFound, "User not found: %q", id)
+	}
+	return user, nil
+}
+
+func (s *Server) createUser(r *request) (interface{}, error) {
+	params := sendbird.UserRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if params.Id == "" {
+		return nil, errorf(ErrCodeInvalidValue, "Invalid value: \"id\".")
+	}
+	if _, ok := s.users[params.Id]; ok {
+		return nil, errorf(ErrCodeUniqueViolation, "\"id\" violates a unique constraint.")
+	}
+
+	user := &sendbird.User{
+		Id:       params.Id,
+		UserId:   params.Id,
+		Nickname: params.Nickname,
+		Picture:  params.ImageUrl,
+		IsActive: true,
+	}
+	if params.IssueAccessToken {
+		user.AccessToken = newToken()
+	}
+	s.users[params.Id] = user
+	return user, nil
+}
+
+func (s *Server) updateUser(r *request) (interface{}, error) {
+	params := sendbird.UserRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	user, err := s.user(params.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Nickname != "" {
+		user.Nickname = params.Nickname
+	}
+	if params.ImageUrl != "" {
+		user.Picture = params.ImageUrl
+	}
+	if params.IssueAccessToken {
+		user.AccessToken = newToken()
+	}
+	return user, nil
+}
+
+func (s *Server) authUser(r *request) (interface{}, error) {
+	params := sendbird.UserRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	user, err := s.user(params.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IssueAccessToken || user.AccessToken == "" {
+		user.AccessToken = newToken()
+	}
+	return user, nil
+}
+
+func (s *Server) blockUser(r *request) (interface{}, error) {
+	params := sendbird.BlockRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.Id); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.TargetId); err != nil {
+		return nil, err
+	}
+
+	if s.blocked[params.Id] == nil {
+		s.blocked[params.Id] = map[string]bool{}
+	}
+	s.blocked[params.Id][params.TargetId] = true
+	return struct{}{}, nil
+}
+
+func (s *Server) unblockUser(r *request) (interface{}, error) {
+	params := sendbird.BlockRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	if _, err := s.user(params.Id); err != nil {
+		return nil, err
+	}
+
+	delete(s.blocked[params.Id], params.TargetId)
+	return struct{}{}, nil
+}
+
+func (s *Server) deactivateUser(r *request) (interface{}, error) {
+	params := sendbird.DeactivateRequest{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+	user, err := s.user(params.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	user.IsActive = false
+	user.AccessToken = ""
+	return struct{}{}, nil
+}
+
+// Blocked returns the ids of the users blocked by a user.
+func (s *Server) Blocked(id string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedKeys(s.blocked[id])
+}
@@ -0,0 +1,52 @@
+package sendbirdtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ippy04/sendbird"
+)
+
+func TestUsers(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	user, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john", Nickname: "John", IssueAccessToken: true})
+	if err != nil {
+		t.Fatalf("User.Create returned error: %v", err)
+	}
+	if user.Id != "john" || user.Nickname != "John" || user.AccessToken == "" {
+		t.Errorf("User.Create returned %+v", user)
+	}
+
+	if _, _, err := client.Users.Create(&sendbird.UserRequest{Id: "john"}); err == nil {
+		t.Errorf("User.Create of an existing user returned no error")
+	}
+
+	user, _, err = client.Users.Update(&sendbird.UserRequest{Id: "john", Nickname: "Johnny"})
+	if err != nil || user.Nickname != "Johnny" {
+		t.Errorf("User.Update returned %+v, %v", user, err)
+	}
+
+	if _, _, err := client.Users.Update(&sendbird.UserRequest{Id: "jane"}); !sendbird.IsUserNotFound(err) {
+		t.Errorf("User.Update of a missing user returned %v, expected a user not found error", err)
+	}
+
+	client.Users.Create(&sendbird.UserRequest{Id: "jane"})
+	if _, err := client.Users.Block(&sendbird.BlockRequest{Id: "john", TargetId: "jane"}); err != nil {
+		t.Fatalf("User.Block returned error: %v", err)
+	}
+	if blocked := srv.Blocked("john"); !reflect.DeepEqual(blocked, []string{"jane"}) {
+		t.Errorf("john blocked %q, expected jane", blocked)
+	}
+	client.Users.UnBlock(&sendbird.BlockRequest{Id: "john", TargetId: "jane"})
+	if blocked := srv.Blocked("john"); len(blocked) != 0 {
+		t.Errorf("john blocked %q after UnBlock", blocked)
+	}
+
+	if _, err := client.Users.Deactivate(&sendbird.DeactivateRequest{Id: "john"}); err != nil {
+		t.Fatalf("User.Deactivate returned error: %v", err)
+	}
+	if stored, _ := srv.User("john"); stored.IsActive {
+		t.Errorf("user still active after Deactivate")
+	}
+}
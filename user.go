@@ -1,5 +1,7 @@
 package sendbird
 
+import "context"
+
 type UserRequest struct {
 	RequestDefaults
 	Id               string `json:"id,omitempty"`
@@ -30,11 +32,17 @@ type User struct {
 // endpoints of the Sendbird API
 type UserService interface {
 	Create(*UserRequest) (*User, *Response, error)
+	CreateWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error)
 	Update(params *UserRequest) (*User, *Response, error)
+	UpdateWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error)
 	Auth(params *UserRequest) (*User, *Response, error)
+	AuthWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error)
 	Block(params *BlockRequest) (*Response, error)
+	BlockWithContext(ctx context.Context, params *BlockRequest) (*Response, error)
 	UnBlock(params *BlockRequest) (*Response, error)
+	UnBlockWithContext(ctx context.Context, params *BlockRequest) (*Response, error)
 	Deactivate(params *DeactivateRequest) (*Response, error)
+	DeactivateWithContext(ctx context.Context, params *DeactivateRequest) (*Response, error)
 }
 
 // UserServiceOp handles communication with the User related methods of
@@ -47,10 +55,19 @@ var _ UserService = &UserServiceOp{}
 
 // Create creates a new user account using id / nickname / profile image combination
 func (s *UserServiceOp) Create(params *UserRequest) (*User, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) CreateWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error) {
+	ctx = withOperation(ctx, "User.Create")
 
 	path := "/user/create"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	createdUser := new(User)
 	resp, err := s.client.Do(req, createdUser)
@@ -66,10 +83,19 @@ func (s *UserServiceOp) Create(params *UserRequest) (*User, *Response, error) {
 
 // Update updates a user
 func (s *UserServiceOp) Update(params *UserRequest) (*User, *Response, error) {
+	return s.UpdateWithContext(context.Background(), params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) UpdateWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error) {
+	ctx = withOperation(ctx, "User.Update")
 
 	path := "/user/update"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	updatedUser := new(User)
 	resp, err := s.client.Do(req, updatedUser)
@@ -83,10 +109,19 @@ func (s *UserServiceOp) Update(params *UserRequest) (*User, *Response, error) {
 
 // Auth retrieve a user's information
 func (s *UserServiceOp) Auth(params *UserRequest) (*User, *Response, error) {
+	return s.AuthWithContext(context.Background(), params)
+}
+
+// AuthWithContext is like Auth but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) AuthWithContext(ctx context.Context, params *UserRequest) (*User, *Response, error) {
+	ctx = withOperation(ctx, "User.Auth")
 
 	path := "/user/auth"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	user := new(User)
 	resp, err := s.client.Do(req, user)
@@ -100,10 +135,19 @@ func (s *UserServiceOp) Auth(params *UserRequest) (*User, *Response, error) {
 
 // Block blocks a target user
 func (s *UserServiceOp) Block(params *BlockRequest) (*Response, error) {
+	return s.BlockWithContext(context.Background(), params)
+}
+
+// BlockWithContext is like Block but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) BlockWithContext(ctx context.Context, params *BlockRequest) (*Response, error) {
+	ctx = withOperation(ctx, "User.Block")
 
 	path := "/user/block"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -117,10 +161,19 @@ func (s *UserServiceOp) Block(params *BlockRequest) (*Response, error) {
 
 // Unblock unblocks a target user.
 func (s *UserServiceOp) UnBlock(params *BlockRequest) (*Response, error) {
+	return s.UnBlockWithContext(context.Background(), params)
+}
+
+// UnBlockWithContext is like UnBlock but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) UnBlockWithContext(ctx context.Context, params *BlockRequest) (*Response, error) {
+	ctx = withOperation(ctx, "User.UnBlock")
 
 	path := "/user/unblock"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
@@ -134,10 +187,19 @@ func (s *UserServiceOp) UnBlock(params *BlockRequest) (*Response, error) {
 
 // Deactivate deactivates a user.
 func (s *UserServiceOp) Deactivate(params *DeactivateRequest) (*Response, error) {
+	return s.DeactivateWithContext(context.Background(), params)
+}
+
+// DeactivateWithContext is like Deactivate but carries ctx through to the underlying HTTP request.
+func (s *UserServiceOp) DeactivateWithContext(ctx context.Context, params *DeactivateRequest) (*Response, error) {
+	ctx = withOperation(ctx, "User.Deactivate")
 
 	path := "/user/deactivate"
 	params.PopulateAuthApiToken(s.client)
-	req, err := s.client.NewRequest("POST", path, *params)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, *params)
+	if err != nil {
+		return nil, err
+	}
 
 	var i interface{}
 	resp, err := s.client.Do(req, i)
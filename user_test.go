@@ -1,11 +1,13 @@
 package sendbird
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestUserCreate(t *testing.T) {
@@ -21,7 +23,7 @@ func TestUserCreate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -76,7 +78,7 @@ func TestUserUpdate(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -131,7 +133,7 @@ func TestUserAuth(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -186,7 +188,7 @@ func TestUserBlock(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -219,7 +221,7 @@ func TestUserUnBlock(t *testing.T) {
 		decoder := json.NewDecoder(r.Body)
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -253,7 +255,7 @@ func TestUserDeactivate(t *testing.T) {
 
 		err := decoder.Decode(&body)
 		if err != nil {
-			t.Errorf("error decoding request json", err)
+			t.Errorf("error decoding request json: %v", err)
 		}
 
 		CheckForAuthParam(t, r, body)
@@ -271,3 +273,30 @@ func TestUserDeactivate(t *testing.T) {
 		t.Errorf("User.Deactivate returned error: %v", err)
 	}
 }
+
+func TestUserCreateWithContextDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	mux.HandleFunc("/user/create", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	user, _, err := client.Users.CreateWithContext(ctx, &UserRequest{Id: "123456"})
+	if err != context.DeadlineExceeded {
+		t.Errorf("User.CreateWithContext returned error %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	if user != nil {
+		t.Errorf("User.CreateWithContext returned %+v, expected nil", user)
+	}
+}
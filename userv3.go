@@ -0,0 +1,418 @@
+package sendbird
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UserV3 is a user as returned by the Platform API v3.
+type UserV3 struct {
+	UserId             string            `json:"user_id"`
+	Nickname           string            `json:"nickname"`
+	ProfileUrl         string            `json:"profile_url"`
+	AccessToken        string            `json:"access_token,omitempty"`
+	SessionTokens      []SessionToken    `json:"session_tokens,omitempty"`
+	IsOnline           bool              `json:"is_online"`
+	IsActive           bool              `json:"is_active"`
+	HasEverLoggedIn    bool              `json:"has_ever_logged_in"`
+	CreatedAt          EpochSeconds      `json:"created_at"`
+	LastSeenAt         EpochMillis       `json:"last_seen_at"` // zero while the user is online or if they never logged in
+	DiscoveryKeys      []string          `json:"discovery_keys,omitempty"`
+	PreferredLanguages []string          `json:"preferred_languages,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+// UserV3List is a page of users. Next is the token of the following page, empty on the last one.
+type UserV3List struct {
+	Users []UserV3 `json:"users"`
+	Next  string   `json:"next"`
+}
+
+// SessionToken is a session token issued to a user.
+type SessionToken struct {
+	Token     string      `json:"session_token"`
+	ExpiresAt EpochMillis `json:"expires_at"`
+}
+
+type UserV3CreateRequest struct {
+	UserId                string            `json:"user_id"`
+	Nickname              string            `json:"nickname"`
+	ProfileUrl            string            `json:"profile_url"`
+	IssueAccessToken      bool              `json:"issue_access_token,omitempty"`
+	IssueSessionToken     bool              `json:"issue_session_token,omitempty"`
+	SessionTokenExpiresAt int64             `json:"session_token_expires_at,omitempty"` // Unix milliseconds
+	DiscoveryKeys         []string          `json:"discovery_keys,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+}
+
+// UserV3UpdateRequest updates a user. Empty fields are left unchanged.
+type UserV3UpdateRequest struct {
+	Nickname                string   `json:"nickname,omitempty"`
+	ProfileUrl              string   `json:"profile_url,omitempty"`
+	IssueAccessToken        bool     `json:"issue_access_token,omitempty"`
+	IsActive                *bool    `json:"is_active,omitempty"`
+	LeaveAllWhenDeactivated *bool    `json:"leave_all_when_deactivated,omitempty"`
+	DiscoveryKeys           []string `json:"discovery_keys,omitempty"`
+	PreferredLanguages      []string `json:"preferred_languages,omitempty"`
+}
+
+// UserV3ListOptions filters and paginates UserV3Service.List. Zero fields are not sent.
+type UserV3ListOptions struct {
+	Token              string   // page token, UserV3List.Next of the previous page
+	Limit              int      // users per page, 1 to 100
+	ActiveMode         string   // "activated", "deactivated" or "all"
+	ShowBot            *bool    // whether to include bots
+	UserIds            []string // only these users
+	Nickname           string   // exact nickname
+	NicknameStartswith string   // nickname prefix
+	MetadataKey        string   // users with this metadata key, matching one of MetadataValues
+	MetadataValues     []string
+}
+
+// values encodes the options as query parameters.
+func (o *UserV3ListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Token != "" {
+		v.Set("token", o.Token)
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.ActiveMode != "" {
+		v.Set("active_mode", o.ActiveMode)
+	}
+	if o.ShowBot != nil {
+		v.Set("show_bot", strconv.FormatBool(*o.ShowBot))
+	}
+	if len(o.UserIds) > 0 {
+		v.Set("user_ids", strings.Join(o.UserIds, ","))
+	}
+	if o.Nickname != "" {
+		v.Set("nickname", o.Nickname)
+	}
+	if o.NicknameStartswith != "" {
+		v.Set("nickname_startswith", o.NicknameStartswith)
+	}
+	if o.MetadataKey != "" {
+		v.Set("metadatakey", o.MetadataKey)
+		v.Set("metadatavalues_in", strings.Join(o.MetadataValues, ","))
+	}
+	return v
+}
+
+// UserV3Service is an interface for interfacing with the /v3/users
+// endpoints of the Sendbird Platform API
+type UserV3Service interface {
+	Create(params *UserV3CreateRequest) (*UserV3, *Response, error)
+	CreateWithContext(ctx context.Context, params *UserV3CreateRequest) (*UserV3, *Response, error)
+	List(opts *UserV3ListOptions) (*UserV3List, *Response, error)
+	ListWithContext(ctx context.Context, opts *UserV3ListOptions) (*UserV3List, *Response, error)
+	Get(userId string) (*UserV3, *Response, error)
+	GetWithContext(ctx context.Context, userId string) (*UserV3, *Response, error)
+	Update(userId string, params *UserV3UpdateRequest) (*UserV3, *Response, error)
+	UpdateWithContext(ctx context.Context, userId string, params *UserV3UpdateRequest) (*UserV3, *Response, error)
+	Delete(userId string) (*Response, error)
+	DeleteWithContext(ctx context.Context, userId string) (*Response, error)
+	IssueSessionToken(userId string, expiresAt time.Time) (*SessionToken, *Response, error)
+	IssueSessionTokenWithContext(ctx context.Context, userId string, expiresAt time.Time) (*SessionToken, *Response, error)
+	RevokeSessionToken(userId string, token string) (*Response, error)
+	RevokeSessionTokenWithContext(ctx context.Context, userId string, token string) (*Response, error)
+	RevokeAllSessionTokens(userId string) (*Response, error)
+	RevokeAllSessionTokensWithContext(ctx context.Context, userId string) (*Response, error)
+	Metadata(userId string) (map[string]string, *Response, error)
+	MetadataWithContext(ctx context.Context, userId string) (map[string]string, *Response, error)
+	CreateMetadata(userId string, metadata map[string]string) (map[string]string, *Response, error)
+	CreateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string) (map[string]string, *Response, error)
+	UpdateMetadata(userId string, metadata map[string]string, upsert bool) (map[string]string, *Response, error)
+	UpdateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string, upsert bool) (map[string]string, *Response, error)
+	DeleteMetadata(userId string, key string) (*Response, error)
+	DeleteMetadataWithContext(ctx context.Context, userId string, key string) (*Response, error)
+	DeleteAllMetadata(userId string) (*Response, error)
+	DeleteAllMetadataWithContext(ctx context.Context, userId string) (*Response, error)
+}
+
+// UserV3ServiceOp handles communication with the /v3/users related
+// methods of the Sendbird Platform API.
+type UserV3ServiceOp struct {
+	client *SendbirdClient
+}
+
+var _ UserV3Service = &UserV3ServiceOp{}
+
+// userV3Path returns the path of a user, followed by elems. An empty user id would address the collection
+// instead, so it is refused.
+func userV3Path(userId string, elems ...string) (string, error) {
+	if userId == "" {
+		return "", fmt.Errorf("sendbird: user id is empty")
+	}
+	return v3Path("users", append([]string{userId}, elems...)...), nil
+}
+
+// Create creates a user
+func (s *UserV3ServiceOp) Create(params *UserV3CreateRequest) (*UserV3, *Response, error) {
+	return s.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext is like Create but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) CreateWithContext(ctx context.Context, params *UserV3CreateRequest) (*UserV3, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.Create")
+
+	user := new(UserV3)
+	resp, err := s.client.call(ctx, "POST", v3Path("users"), params, user)
+	if err != nil {
+		return nil, resp, err
+	}
+	return user, resp, nil
+}
+
+// List returns a page of users matching opts, which may be nil
+func (s *UserV3ServiceOp) List(opts *UserV3ListOptions) (*UserV3List, *Response, error) {
+	return s.ListWithContext(context.Background(), opts)
+}
+
+// ListWithContext is like List but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) ListWithContext(ctx context.Context, opts *UserV3ListOptions) (*UserV3List, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.List")
+
+	path := v3Path("users")
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+
+	list := new(UserV3List)
+	resp, err := s.client.call(ctx, "GET", path, nil, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// Get retrieves a user
+func (s *UserV3ServiceOp) Get(userId string) (*UserV3, *Response, error) {
+	return s.GetWithContext(context.Background(), userId)
+}
+
+// GetWithContext is like Get but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) GetWithContext(ctx context.Context, userId string) (*UserV3, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.Get")
+
+	path, err := userV3Path(userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(UserV3)
+	resp, err := s.client.call(ctx, "GET", path, nil, user)
+	if err != nil {
+		return nil, resp, err
+	}
+	return user, resp, nil
+}
+
+// Update updates a user
+func (s *UserV3ServiceOp) Update(userId string, params *UserV3UpdateRequest) (*UserV3, *Response, error) {
+	return s.UpdateWithContext(context.Background(), userId, params)
+}
+
+// UpdateWithContext is like Update but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) UpdateWithContext(ctx context.Context, userId string, params *UserV3UpdateRequest) (*UserV3, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.Update")
+
+	path, err := userV3Path(userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(UserV3)
+	resp, err := s.client.call(ctx, "PUT", path, params, user)
+	if err != nil {
+		return nil, resp, err
+	}
+	return user, resp, nil
+}
+
+// Delete deletes a user
+func (s *UserV3ServiceOp) Delete(userId string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), userId)
+}
+
+// DeleteWithContext is like Delete but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) DeleteWithContext(ctx context.Context, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "UserV3.Delete")
+
+	path, err := userV3Path(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// IssueSessionToken issues a session token to a user, valid until expiresAt or for the application's default
+// duration when expiresAt is zero
+func (s *UserV3ServiceOp) IssueSessionToken(userId string, expiresAt time.Time) (*SessionToken, *Response, error) {
+	return s.IssueSessionTokenWithContext(context.Background(), userId, expiresAt)
+}
+
+// IssueSessionTokenWithContext is like IssueSessionToken but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) IssueSessionTokenWithContext(ctx context.Context, userId string, expiresAt time.Time) (*SessionToken, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.IssueSessionToken")
+
+	path, err := userV3Path(userId, "token")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := struct {
+		ExpiresAt int64 `json:"expires_at,omitempty"`
+	}{}
+	if !expiresAt.IsZero() {
+		params.ExpiresAt = expiresAt.UnixMilli()
+	}
+
+	// unlike UserV3.SessionTokens, the issued token comes in a "token" field
+	issued := struct {
+		Token     string      `json:"token"`
+		ExpiresAt EpochMillis `json:"expires_at"`
+	}{}
+	resp, err := s.client.call(ctx, "POST", path, params, &issued)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &SessionToken{Token: issued.Token, ExpiresAt: issued.ExpiresAt}, resp, nil
+}
+
+// RevokeSessionToken revokes one session token of a user
+func (s *UserV3ServiceOp) RevokeSessionToken(userId string, token string) (*Response, error) {
+	return s.RevokeSessionTokenWithContext(context.Background(), userId, token)
+}
+
+// RevokeSessionTokenWithContext is like RevokeSessionToken but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) RevokeSessionTokenWithContext(ctx context.Context, userId string, token string) (*Response, error) {
+	ctx = withOperation(ctx, "UserV3.RevokeSessionToken")
+
+	path, err := userV3Path(userId, "token", token)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("sendbird: session token of user %q is empty", userId)
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// RevokeAllSessionTokens revokes every session token of a user
+func (s *UserV3ServiceOp) RevokeAllSessionTokens(userId string) (*Response, error) {
+	return s.RevokeAllSessionTokensWithContext(context.Background(), userId)
+}
+
+// RevokeAllSessionTokensWithContext is like RevokeAllSessionTokens but carries ctx through to the underlying HTTP
+// request.
+func (s *UserV3ServiceOp) RevokeAllSessionTokensWithContext(ctx context.Context, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "UserV3.RevokeAllSessionTokens")
+
+	path, err := userV3Path(userId, "token")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// Metadata retrieves the metadata of a user
+func (s *UserV3ServiceOp) Metadata(userId string) (map[string]string, *Response, error) {
+	return s.MetadataWithContext(context.Background(), userId)
+}
+
+// MetadataWithContext is like Metadata but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) MetadataWithContext(ctx context.Context, userId string) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.Metadata")
+
+	path, err := userV3Path(userId, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.callMetadata(ctx, "GET", path, nil)
+}
+
+// CreateMetadata adds metadata to a user. It fails when a key already exists, see UpdateMetadata.
+func (s *UserV3ServiceOp) CreateMetadata(userId string, metadata map[string]string) (map[string]string, *Response, error) {
+	return s.CreateMetadataWithContext(context.Background(), userId, metadata)
+}
+
+// CreateMetadataWithContext is like CreateMetadata but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) CreateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.CreateMetadata")
+
+	path, err := userV3Path(userId, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := metadataRequest{Metadata: metadata}
+	return s.client.callMetadata(ctx, "POST", path, params)
+}
+
+// UpdateMetadata updates existing metadata keys of a user, adding missing ones when upsert is set
+func (s *UserV3ServiceOp) UpdateMetadata(userId string, metadata map[string]string, upsert bool) (map[string]string, *Response, error) {
+	return s.UpdateMetadataWithContext(context.Background(), userId, metadata, upsert)
+}
+
+// UpdateMetadataWithContext is like UpdateMetadata but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) UpdateMetadataWithContext(ctx context.Context, userId string, metadata map[string]string, upsert bool) (map[string]string, *Response, error) {
+	ctx = withOperation(ctx, "UserV3.UpdateMetadata")
+
+	path, err := userV3Path(userId, "metadata")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := metadataRequest{Metadata: metadata, Upsert: upsert}
+	return s.client.callMetadata(ctx, "PUT", path, params)
+}
+
+// DeleteMetadata deletes a metadata key of a user
+func (s *UserV3ServiceOp) DeleteMetadata(userId string, key string) (*Response, error) {
+	return s.DeleteMetadataWithContext(context.Background(), userId, key)
+}
+
+// DeleteMetadataWithContext is like DeleteMetadata but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) DeleteMetadataWithContext(ctx context.Context, userId string, key string) (*Response, error) {
+	ctx = withOperation(ctx, "UserV3.DeleteMetadata")
+
+	path, err := userV3Path(userId, "metadata", key)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("sendbird: metadata key of user %q is empty", userId)
+	}
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
+
+// DeleteAllMetadata deletes every metadata key of a user
+func (s *UserV3ServiceOp) DeleteAllMetadata(userId string) (*Response, error) {
+	return s.DeleteAllMetadataWithContext(context.Background(), userId)
+}
+
+// DeleteAllMetadataWithContext is like DeleteAllMetadata but carries ctx through to the underlying HTTP request.
+func (s *UserV3ServiceOp) DeleteAllMetadataWithContext(ctx context.Context, userId string) (*Response, error) {
+	ctx = withOperation(ctx, "UserV3.DeleteAllMetadata")
+
+	path, err := userV3Path(userId, "metadata")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.call(ctx, "DELETE", path, nil, nil)
+}
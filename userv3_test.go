@@ -0,0 +1,369 @@
+package sendbird
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const userV3Response = `
+{
+    "user_id": "john",
+    "nickname": "John",
+    "profile_url": "https://sendbird.com/main/img/profiles/profile_05_512px.png",
+    "access_token": "ABCDEFG",
+    "is_online": false,
+    "is_active": true,
+    "has_ever_logged_in": true,
+    "created_at": 1542123432,
+    "last_seen_at": 1542123999123,
+    "discovery_keys": ["john@sendbird.com"],
+    "preferred_languages": [],
+    "metadata": {"font_preference": "times new roman"}
+}`
+
+var expectedUserV3 = &UserV3{
+	UserId:             "john",
+	Nickname:           "John",
+	ProfileUrl:         "https://sendbird.com/main/img/profiles/profile_05_512px.png",
+	AccessToken:        "ABCDEFG",
+	IsActive:           true,
+	HasEverLoggedIn:    true,
+	CreatedAt:          EpochSeconds{time.Unix(1542123432, 0)},
+	LastSeenAt:         EpochMillis{time.UnixMilli(1542123999123)},
+	DiscoveryKeys:      []string{"john@sendbird.com"},
+	PreferredLanguages: []string{},
+	Metadata:           map[string]string{"font_preference": "times new roman"},
+}
+
+func TestUserV3Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := UserV3CreateRequest{
+		UserId:           "john",
+		Nickname:         "John",
+		ProfileUrl:       "https://sendbird.com/main/img/profiles/profile_05_512px.png",
+		IssueAccessToken: true,
+		Metadata:         map[string]string{"font_preference": "times new roman"},
+	}
+
+	mux.HandleFunc("/v3/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		CheckForAuthContentType(t, r)
+		CheckForApiTokenHeader(t, r)
+
+		body := UserV3CreateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		if !reflect.DeepEqual(body, createRequest) {
+			t.Errorf("UserV3.Create API call received %+v, expected %+v", body, createRequest)
+		}
+
+		fmt.Fprint(w, userV3Response)
+	})
+
+	user, _, err := client.UsersV3.Create(&createRequest)
+	if err != nil {
+		t.Errorf("UserV3.Create returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(user, expectedUserV3) {
+		t.Errorf("UserV3.Create returned %+v, expected %+v", user, expectedUserV3)
+	}
+}
+
+func TestUserV3List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+
+		expected := "active_mode=activated&limit=10&metadatakey=font_preference&metadatavalues_in=times+new+roman%2Carial&nickname_startswith=Jo&show_bot=false&token=NEXT_PAGE"
+		if r.URL.RawQuery != expected {
+			t.Errorf("UserV3.List query = %q, expected %q", r.URL.RawQuery, expected)
+		}
+
+		fmt.Fprintf(w, `{"users": [%s], "next": ""}`, userV3Response)
+	})
+
+	showBot := false
+	list, _, err := client.UsersV3.List(&UserV3ListOptions{
+		Token:              "NEXT_PAGE",
+		Limit:              10,
+		ActiveMode:         "activated",
+		ShowBot:            &showBot,
+		NicknameStartswith: "Jo",
+		MetadataKey:        "font_preference",
+		MetadataValues:     []string{"times new roman", "arial"},
+	})
+	if err != nil {
+		t.Errorf("UserV3.List returned error: %v", err)
+	}
+
+	expected := &UserV3List{Users: []UserV3{*expectedUserV3}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("UserV3.List returned %+v, expected %+v", list, expected)
+	}
+}
+
+func TestUserV3ListWithoutOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("UserV3.List query = %q, expected none", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"users": [], "next": ""}`)
+	})
+
+	if _, _, err := client.UsersV3.List(nil); err != nil {
+		t.Errorf("UserV3.List returned error: %v", err)
+	}
+}
+
+func TestUserV3Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users/john", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		CheckForApiTokenHeader(t, r)
+
+		fmt.Fprint(w, userV3Response)
+	})
+
+	user, _, err := client.UsersV3.Get("john")
+	if err != nil {
+		t.Errorf("UserV3.Get returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(user, expectedUserV3) {
+		t.Errorf("UserV3.Get returned %+v, expected %+v", user, expectedUserV3)
+	}
+}
+
+func TestUserV3GetNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users/ghost", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "\"User\" not found.", "code": 400201, "error": true}`)
+	})
+
+	_, _, err := client.UsersV3.Get("ghost")
+	if !IsUserNotFound(err) {
+		t.Errorf("UserV3.Get returned %v, expected a user not found error", err)
+	}
+}
+
+func TestUserV3Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	active := false
+	updateRequest := UserV3UpdateRequest{Nickname: "Johnny", IsActive: &active}
+
+	mux.HandleFunc("/v3/users/john", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		CheckForApiTokenHeader(t, r)
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+
+		expected := map[string]interface{}{"nickname": "Johnny", "is_active": false}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("UserV3.Update API call received %+v, expected %+v", body, expected)
+		}
+
+		fmt.Fprint(w, userV3Response)
+	})
+
+	if _, _, err := client.UsersV3.Update("john", &updateRequest); err != nil {
+		t.Errorf("UserV3.Update returned error: %v", err)
+	}
+}
+
+func TestUserV3Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+
+		if r.URL.EscapedPath() != "/v3/users/john%2Fdoe" {
+			t.Errorf("UserV3.Delete path = %q, expected the user id escaped", r.URL.EscapedPath())
+		}
+
+		CheckForApiTokenHeader(t, r)
+
+		fmt.Fprint(w, "{}")
+	})
+
+	if _, err := client.UsersV3.Delete("john/doe"); err != nil {
+		t.Errorf("UserV3.Delete returned error: %v", err)
+	}
+}
+
+func TestUserV3SessionTokens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expiresAt := time.UnixMilli(1542123999000)
+	var revoked []string
+
+	mux.HandleFunc("/v3/users/john/token", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+
+		if r.Method == "DELETE" {
+			revoked = append(revoked, "all")
+			fmt.Fprint(w, "{}")
+			return
+		}
+		testMethod(t, r, "POST")
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request json: %v", err)
+		}
+		if body["expires_at"] != float64(1542123999000) {
+			t.Errorf("UserV3.IssueSessionToken API call received %+v, expected expires_at", body)
+		}
+
+		fmt.Fprint(w, `{"token": "SESSION_TOKEN", "expires_at": 1542123999000}`)
+	})
+	mux.HandleFunc("/v3/users/john/token/SESSION_TOKEN", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		revoked = append(revoked, "SESSION_TOKEN")
+		fmt.Fprint(w, "{}")
+	})
+
+	token, _, err := client.UsersV3.IssueSessionToken("john", expiresAt)
+	if err != nil {
+		t.Fatalf("UserV3.IssueSessionToken returned error: %v", err)
+	}
+
+	expected := &SessionToken{Token: "SESSION_TOKEN", ExpiresAt: EpochMillis{expiresAt}}
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("UserV3.IssueSessionToken returned %+v, expected %+v", token, expected)
+	}
+
+	if _, err := client.UsersV3.RevokeSessionToken("john", token.Token); err != nil {
+		t.Errorf("UserV3.RevokeSessionToken returned error: %v", err)
+	}
+	if _, err := client.UsersV3.RevokeAllSessionTokens("john"); err != nil {
+		t.Errorf("UserV3.RevokeAllSessionTokens returned error: %v", err)
+	}
+	if _, err := client.UsersV3.RevokeSessionToken("john", ""); err == nil {
+		t.Errorf("UserV3.RevokeSessionToken accepted an empty token")
+	}
+
+	if !reflect.DeepEqual(revoked, []string{"SESSION_TOKEN", "all"}) {
+		t.Errorf("revoked %v, expected the token then all tokens", revoked)
+	}
+}
+
+func TestUserV3Metadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	metadata := map[string]string{"font_preference": "arial"}
+	var calls []string
+
+	mux.HandleFunc("/v3/users/john/metadata", func(w http.ResponseWriter, r *http.Request) {
+		CheckForApiTokenHeader(t, r)
+		calls = append(calls, r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		switch r.Method {
+		case "POST":
+			expected := map[string]interface{}{"metadata": map[string]interface{}{"font_preference": "arial"}}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("UserV3.CreateMetadata API call received %+v, expected %+v", body, expected)
+			}
+		case "PUT":
+			expected := map[string]interface{}{"metadata": map[string]interface{}{"font_preference": "arial"}, "upsert": true}
+			if !reflect.DeepEqual(body, expected) {
+				t.Errorf("UserV3.UpdateMetadata API call received %+v, expected %+v", body, expected)
+			}
+		case "DELETE":
+			fmt.Fprint(w, "{}")
+			return
+		}
+		fmt.Fprint(w, `{"font_preference": "arial"}`)
+	})
+	mux.HandleFunc("/v3/users/john/metadata/font_preference", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "DELETE key")
+		fmt.Fprint(w, "{}")
+	})
+
+	if got, _, err := client.UsersV3.CreateMetadata("john", metadata); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("UserV3.CreateMetadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if got, _, err := client.UsersV3.UpdateMetadata("john", metadata, true); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("UserV3.UpdateMetadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if got, _, err := client.UsersV3.Metadata("john"); err != nil || !reflect.DeepEqual(got, metadata) {
+		t.Errorf("UserV3.Metadata returned %v, %v, expected %v", got, err, metadata)
+	}
+	if _, err := client.UsersV3.DeleteMetadata("john", "font_preference"); err != nil {
+		t.Errorf("UserV3.DeleteMetadata returned error: %v", err)
+	}
+	if _, err := client.UsersV3.DeleteAllMetadata("john"); err != nil {
+		t.Errorf("UserV3.DeleteAllMetadata returned error: %v", err)
+	}
+
+	expected := []string{"POST", "PUT", "GET", "DELETE key", "DELETE"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("calls = %v, expected %v", calls, expected)
+	}
+}
+
+func TestUserV3EmptyUserId(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/users/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s reached the server", r.Method, r.URL.Path)
+	})
+
+	if _, _, err := client.UsersV3.Get(""); err == nil {
+		t.Errorf("UserV3.Get accepted an empty user id")
+	}
+	if _, _, err := client.UsersV3.Update("", &UserV3UpdateRequest{Nickname: "John"}); err == nil {
+		t.Errorf("UserV3.Update accepted an empty user id")
+	}
+	if _, err := client.UsersV3.Delete(""); err == nil {
+		t.Errorf("UserV3.Delete accepted an empty user id")
+	}
+	if _, _, err := client.UsersV3.IssueSessionToken("", time.Time{}); err == nil {
+		t.Errorf("UserV3.IssueSessionToken accepted an empty user id")
+	}
+	if _, err := client.UsersV3.RevokeAllSessionTokens(""); err == nil {
+		t.Errorf("UserV3.RevokeAllSessionTokens accepted an empty user id")
+	}
+	if _, _, err := client.UsersV3.Metadata(""); err == nil {
+		t.Errorf("UserV3.Metadata accepted an empty user id")
+	}
+	if _, err := client.UsersV3.DeleteAllMetadata(""); err == nil {
+		t.Errorf("UserV3.DeleteAllMetadata accepted an empty user id")
+	}
+}